@@ -25,17 +25,25 @@ grammar TDTL;
 
 // 1. Tokens & KeyWord
 // 1.1 KeyWord
+// CREATE, DECIMAL, FUNCTION, IMPORT, INTERVAL and TIMESTAMP are keywords
+// only where a rule expects them, anywhere else they are identifiers.
 INSERT:                 I N S E R T;
 INTO:                   I N T O;
 AS:                     STUFF A S STUFF;
 AND:                    STUFF A N D STUFF;
 CASE:                   STUFF C A S E STUFF;
+CREATE:                 C R E A T E;
+DECIMAL:                D E C I M A L;
 ELSE:                   STUFF E L S E STUFF;
 END:                    STUFF E N D STUFF;
 EQ:                     E Q     | '=';
 FROM:                   STUFF F R O M STUFF;
+FUNCTION:               F U N C T I O N;
 GT:                     G T     | '>';
 GTE:                    G T E   | '>' '=';
+IMPORT:                 I M P O R T;
+IN:                     STUFF I N STUFF;
+INTERVAL:               I N T E R V A L;
 LT:                     L T     | '<';
 LTE:                    L T E   | '<' '=';
 NE:                     N E     | '!' '=' | '<' '>';
@@ -44,6 +52,7 @@ NULL:                   N U L L;
 OR:                     STUFF O R STUFF;
 SELECT:                 S E L E C T STUFF;
 THEN:                   STUFF T H E N STUFF;
+TIMESTAMP:              T I M E S T A M P;
 WHERE:                  STUFF W H E R E STUFF;
 WHEN:                   STUFF W H E N STUFF;
 
@@ -72,10 +81,33 @@ WHITESPACE:         [ \r\n\t]+ -> skip;
 
 // 2. Rules
 root
-    : INSERT INTO target SELECT fields EOF;
+    : clause* INSERT INTO target SELECT fields EOF;
+
+script
+    : clause* EOF
+    ;
+
+expression
+    : clause* field_elem
+    ;
+
+clause
+    : IMPORT import_elem (',' import_elem)*                                     # Import
+    | CREATE FUNCTION name=(INDENTIFIER | PATHITEM | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP)
+      '(' param_list? ')' AS expr                                               # CreateFunction
+    ;
+
+import_elem
+    : name=(INDENTIFIER | PATHITEM | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP)
+      (AS alias=(INDENTIFIER | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP))?
+    ;
+
+param_list
+    : (INDENTIFIER | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP) (',' (INDENTIFIER | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP))*
+    ;
 
 target
-    : INDENTIFIER
+    : INDENTIFIER | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP
     ;
 
 // 2.1 Select
@@ -119,6 +151,7 @@ expr
    | expr op=('*'|'/'|'%') expr                     # Binary
    | expr op=('+'|'-') expr                         # Binary
    | expr op=(EQ | GT | LT | GTE | LTE | NE) expr   # Binary
+   | expr NOT? IN '(' expr (',' expr)* ')'          # In
    | call_expr                                      # Function
    | switch_stmt                                    # Switch
    ;
@@ -131,7 +164,7 @@ source_stmt
 */
 
 sourceEntity
-    :INDENTIFIER
+    : INDENTIFIER | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP
     ;
 
 propertyEntity
@@ -145,6 +178,7 @@ constant
     | INTEGER                                        # Integer
     | FLOAT                                          # Float
     | STRING                                         # String
+    | kind=(DECIMAL | INTERVAL | TIMESTAMP) STRING   # TypedLiteral
     | xpath_name                                     # Source
     ;
 
@@ -156,7 +190,7 @@ switch_stmt
     ;
 
 call_expr
-    : key=INDENTIFIER '(' (expr (',' expr)*)? ')';
+    : key=(INDENTIFIER | PATHITEM | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP) '(' (expr (',' expr)*)? ')';
 
 /*
 CASE v WHEN t[1] THEN r[1]
@@ -182,6 +216,7 @@ target_name
 dotnotation
     : INDENTIFIER
     | PATHITEM
+    | CREATE | DECIMAL | FUNCTION | IMPORT | INTERVAL | TIMESTAMP
     ;
 
 identifierWithTOPICITEM
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+
+	"github.com/tkeel-io/tdtl/parser"
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+)
+
+// evalBinaryBroadcast eval arithmetic element-wise over arrays,
+// a scalar operand is applied to every element of the array operand
+// and two arrays must have the same length.
+// An element whose result is undefined is set to null.
+func evalBinaryBroadcast(o *options, op int, lhs, rhs Node) Node {
+	left, lok := arrayElements(lhs)
+	right, rok := arrayElements(rhs)
+	switch {
+	case lok && rok:
+		if len(left) != len(right) {
+			return errorNode(fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(left), len(right)))
+		}
+	case lok:
+		right = repeatNode(rhs, len(left))
+	case rok:
+		left = repeatNode(lhs, len(right))
+	default:
+		return UNDEFINED_RESULT
+	}
+
+	ret := New("[]")
+	for i := range left {
+		node := evalBinaryOverload(op, left[i], right[i])
+		if node == nil {
+			node = evalBinary(o, op, left[i], right[i])
+		}
+		if err := node.Error(); err != nil {
+			return node
+		}
+		if node.Type() == Undefined {
+			node = NULL_RESULT
+		}
+		ret.Append("", node)
+	}
+	return ret.Node()
+}
+
+// arrayElements returns the elements of an array node, ok is false for other nodes.
+func arrayElements(node Node) ([]Node, bool) {
+	cc, ok := structuralNode(node).(JSONNode)
+	if !ok || cc.datatype != Array {
+		return nil, false
+	}
+	ret := make([]Node, 0)
+	gjson.ParseBytes(cc.value).ForEach(func(_, value gjson.Result) bool {
+		ret = append(ret, elementNode(value))
+		return true
+	})
+	return ret, true
+}
+
+// elementNode converts json result to node, numbers are Int or Float as in a context.
+func elementNode(ret gjson.Result) Node {
+	node := _gjson2JsonNode(ret)
+	if ret.Type == gjson.Number && node.Type() == Undefined {
+		return StringNode(ret.Raw).To(Float)
+	}
+	return node
+}
+
+func repeatNode(node Node, n int) []Node {
+	ret := make([]Node, n)
+	for i := range ret {
+		ret[i] = node
+	}
+	return ret
+}
+
+func isArithmeticOP(op int) bool {
+	switch op {
+	case parser.TDTLParserADD,
+		parser.TDTLParserSUB,
+		parser.TDTLParserMUL,
+		parser.TDTLParserDIV,
+		parser.TDTLParserMOD:
+		return true
+	}
+	return false
+}
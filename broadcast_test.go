@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcastEval(t *testing.T) {
+	ctx := NewJSONContext(`{
+		"entity": {
+			"voltages": [22000, 22150, 21980],
+			"a": [1, 2.5, 3],
+			"b": [1, 0.5, 4],
+			"c": [1, 2],
+			"m": [[1, 2], [3, 4]],
+			"names": ["x", "y"],
+			"loc": {"lat": 1}
+		}
+	}`)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`entity.voltages * 0.01`, "[220,221.5,219.8]"},
+		{`entity.voltages / 100`, "[220,221,219]"},
+		{`entity.a - entity.b`, "[0,2,-1]"},
+		{`10 - entity.c`, "[9,8]"},
+		{`entity.c % 2`, "[1,0]"},
+		{`entity.m * 2`, "[[2,4],[6,8]]"},
+		{`entity.m + entity.m`, "[[2,4],[6,8]]"},
+		{`entity.names + '_1'`, `["x_1","y_1"]`},
+		{`entity.c * 'x'`, "[null,null]"},
+		{`entity.loc * 2`, ""},
+		{`entity.voltages > 1`, ""},
+		{`entity.loc <= 'x'`, ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, EvalRuleQL(ctx, expr).String(), tt.expr)
+	}
+
+	for _, raw := range []string{`entity.a - entity.c`, `entity.c / 0`} {
+		expr, err := ParseExpr(raw)
+		assert.NoError(t, err, raw)
+		ret := EvalRuleQL(ctx, expr)
+		assert.Equal(t, Undefined, ret.Type(), raw)
+		assert.Error(t, ret.Error(), raw)
+	}
+	expr, _ := ParseExpr(`entity.a - entity.c`)
+	assert.True(t, errors.Is(EvalRuleQL(ctx, expr).Error(), ErrLengthMismatch))
+}
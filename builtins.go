@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+// Builtins is the registry of the built-in functions, a function registered
+// here is callable from every rule.
+var Builtins = newBuiltins()
+
+func newBuiltins() *FunctionRegistry {
+	r := NewFunctionRegistry()
+	for _, fn := range builtinFunctions {
+		if err := r.register(fn); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// the types accepted by the parameters of the built-in functions. A point of the
+// geo functions is either lat, lng or a single object in place of lat.
+var (
+	numberTypes  = []Type{Number}
+	stringTypes  = []Type{String}
+	dataTypes    = []Type{String, Bytes, Object, Array, Number, Bool}
+	payloadTypes = []Type{Bytes, String}
+	timeTypes    = []Type{Timestamp, Number, String}
+	docTypes     = []Type{Object, Array, String}
+	pointTypes   = []Type{Number, Object, Array, String}
+)
+
+var builtinFunctions = []Function{
+	{Name: "abs", Description: "Returns the absolute value of x, in the type of x.",
+		Params: []Param{{"x", numberTypes}}, Result: Number, resultOf: sameAsFirst, Pure: true, Call: absFunc},
+	{Name: "atan2", Description: "Returns the arc tangent of y/x in radians.",
+		Params: []Param{{"y", numberTypes}, {"x", numberTypes}}, Result: Float, Pure: true, Call: atan2Func},
+	{Name: "base64", Description: "Encodes x in standard base64.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: base64Func},
+	{Name: "base64_decode", Description: "Decodes standard or url-safe base64, padded or not.",
+		Params: []Param{{"s", stringTypes}}, Result: Bytes, Pure: true, Call: base64DecodeFunc},
+	{Name: "base64url", Description: "Encodes x in unpadded url-safe base64, as in JWT.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: base64urlFunc},
+	{Name: "bit", Description: "Reports whether bit n of the integer x is set, bit 0 is the least significant.",
+		Params: []Param{{"x", numberTypes}, {"n", numberTypes}}, Result: Bool, Pure: true, Call: bitFunc},
+	{Name: "bits", Description: "Returns the unsigned value of len bits of the integer x from bit from.",
+		Params: []Param{{"x", numberTypes}, {"from", numberTypes}, {"len", numberTypes}}, Result: Int, Pure: true, Call: bitsFunc},
+	{Name: "bytes", Description: "Converts s to bytes, decoded by a base64: or hex: hint, else the bytes of the text.",
+		Params: []Param{{"s", payloadTypes}}, Result: Bytes, Pure: true, Call: bytesFunc},
+	{Name: "bytes_at", Description: "Returns the byte at offset as an Int, or length bytes from offset.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}, {"length", numberTypes}}, Optional: 1,
+		resultOf: bytesAtResult, Pure: true, Call: bytesAtFunc},
+	{Name: "ceil", Description: "Rounds x up to n fractional digits, 0 by default.",
+		Params: []Param{{"x", numberTypes}, {"n", numberTypes}}, Optional: 1, Result: Number, resultOf: roundResult, Pure: true, Call: ceilFunc},
+	{Name: "changed", Description: "Reports whether x differs from its value in the previous Exec of the rule.",
+		Params: []Param{{"x", nil}}, Result: Bool, callState: changedFunc},
+	{Name: "clamp", Description: "Limits x to the range [lo, hi].",
+		Params: []Param{{"x", numberTypes}, {"lo", numberTypes}, {"hi", numberTypes}}, Result: Number, resultOf: commonNumber, Pure: true, Call: clampFunc},
+	{Name: "concat", Description: "Joins the text of its arguments.",
+		Params: []Param{{"x", nil}}, Optional: 1, Variadic: true, Result: String, Pure: true, Call: concatFunc},
+	{Name: "contains", Description: "Reports whether s contains substr.",
+		Params: []Param{{"s", stringTypes}, {"substr", stringTypes}}, Result: Bool, Pure: true, Call: containsFunc},
+	{Name: "convert", Description: "Converts value between units of the unit registry of the rule, a decimal value gives a Decimal.",
+		Params: []Param{{"value", numberTypes}, {"from", stringTypes}, {"to", stringTypes}}, Result: Number, resultOf: convertResult,
+		Pure: true, Call: defaultOptionFunc(convertFunc), callWith: convertFunc},
+	{Name: "cos", Description: "Returns the cosine of x in radians.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: cosFunc},
+	{Name: "counter_reset", Description: "Reports whether the counter x decreased since the previous Exec of the rule.",
+		Params: []Param{{"x", numberTypes}}, Result: Bool, callState: counterResetFunc},
+	{Name: "crc16", Description: "Returns the CRC-16/MODBUS of x, as used by Modbus RTU frames.",
+		Params: []Param{{"x", dataTypes}}, Result: Int, Pure: true, Call: crc16Func},
+	{Name: "crc32", Description: "Returns the CRC-32 (IEEE) of x.",
+		Params: []Param{{"x", dataTypes}}, Result: Int, Pure: true, Call: crc32Func},
+	{Name: "delta", Description: "Returns x minus its value in the previous Exec of the rule.",
+		Params: []Param{{"x", numberTypes}}, Result: Number, resultOf: sameAsFirst, callState: deltaFunc},
+	{Name: "date_add", Description: "Adds n units to ts, days and larger units follow the calendar of the timezone, the day is clamped to the end of the month.",
+		Params: []Param{{"unit", stringTypes}, {"n", numberTypes}, {"ts", timeTypes}, {"tz", stringTypes}}, Optional: 1, Result: Timestamp,
+		Pure: true, Call: defaultOptionFunc(dateAddFunc), callWith: dateAddFunc},
+	{Name: "date_diff", Description: "Returns the number of whole units from from to to, negative if to is before from.",
+		Params: []Param{{"unit", stringTypes}, {"from", timeTypes}, {"to", timeTypes}, {"tz", stringTypes}}, Optional: 1, Result: Int,
+		Pure: true, Call: defaultOptionFunc(dateDiffFunc), callWith: dateDiffFunc},
+	{Name: "date_trunc", Description: "Truncates ts to the start of unit in the timezone.",
+		Params: []Param{{"unit", stringTypes}, {"ts", timeTypes}, {"tz", stringTypes}}, Optional: 1, Result: Timestamp,
+		Pure: true, Call: defaultOptionFunc(dateTruncFunc), callWith: dateTruncFunc},
+	{Name: "day_of_week", Description: "Returns the ISO day of week of ts in the timezone, 1 for Monday to 7 for Sunday.",
+		Params: []Param{{"ts", timeTypes}, {"tz", stringTypes}}, Optional: 1, Result: Int,
+		Pure: true, Call: defaultOptionFunc(dayOfWeekFunc), callWith: dayOfWeekFunc},
+	{Name: "decimal", Description: "Converts x to an arbitrary-precision Decimal.",
+		Params: []Param{{"x", []Type{Number, String}}}, Result: Decimal, Pure: true, Call: decimalFunc},
+	{Name: "ends_with", Description: "Reports whether s ends with suffix.",
+		Params: []Param{{"s", stringTypes}, {"suffix", stringTypes}}, Result: Bool, Pure: true, Call: endsWithFunc},
+	{Name: "exp", Description: "Returns e**x.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: expFunc},
+	{Name: "float32_be", Description: "Decodes a big-endian float32 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Float, Pure: true, Call: float32BEFunc},
+	{Name: "float32_le", Description: "Decodes a little-endian float32 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Float, Pure: true, Call: float32LEFunc},
+	{Name: "floor", Description: "Rounds x down to n fractional digits, 0 by default.",
+		Params: []Param{{"x", numberTypes}, {"n", numberTypes}}, Optional: 1, Result: Number, resultOf: roundResult, Pure: true, Call: floorFunc},
+	{Name: "format", Description: "Formats its arguments as fmt.Sprintf, each verb must fit its argument.",
+		Params: []Param{{"layout", stringTypes}, {"x", nil}}, Optional: 1, Variadic: true, Result: String, Pure: true, Call: formatFunc},
+	{Name: "format_time", Description: "Formats ts in the timezone, layout is a Go reference layout or a name such as 'rfc3339'.",
+		Params: []Param{{"ts", timeTypes}, {"layout", stringTypes}, {"tz", stringTypes}}, Optional: 1, Result: String,
+		Pure: true, Call: defaultOptionFunc(formatTimeFunc), callWith: formatTimeFunc},
+	{Name: "from_unixtime", Description: "Returns the timestamp of an epoch in seconds, or in the unit 's', 'ms', 'us' or 'ns'.",
+		Params: []Param{{"epoch", numberTypes}, {"unit", stringTypes}}, Optional: 1, Result: Timestamp, Pure: true, Call: fromUnixtimeFunc},
+	{Name: "geo_bbox", Description: "Reports whether a point is inside the bounding box, a box with min_lng > max_lng crosses the antimeridian.",
+		Params: []Param{{"lat", pointTypes}, {"lng", numberTypes}, {"min_lat", numberTypes}, {"min_lng", numberTypes}, {"max_lat", numberTypes}, {"max_lng", numberTypes}}, Optional: 1,
+		Result: Bool, Pure: true, Call: geoBboxFunc},
+	{Name: "geo_bearing", Description: "Returns the initial bearing between two points in degrees clockwise from north.",
+		Params: []Param{{"lat1", pointTypes}, {"lng1", pointTypes}, {"lat2", pointTypes}, {"lng2", numberTypes}}, Optional: 2, Result: Float, Pure: true, Call: geoBearingFunc},
+	{Name: "geo_distance", Description: "Returns the great-circle distance in meters between two points.",
+		Params: []Param{{"lat1", pointTypes}, {"lng1", pointTypes}, {"lat2", pointTypes}, {"lng2", numberTypes}}, Optional: 2, Result: Float, Pure: true, Call: geoDistanceFunc},
+	{Name: "geo_within", Description: "Reports whether a point is inside a polygon, a GeoJSON Polygon or MultiPolygon.",
+		Params: []Param{{"lat", pointTypes}, {"lng", pointTypes}, {"polygon", docTypes}}, Optional: 1, Result: Bool, Pure: true, Call: geoWithinFunc},
+	{Name: "geohash_decode", Description: "Returns the center of the cell of hash as an object {\"lat\":..,\"lng\":..}.",
+		Params: []Param{{"hash", stringTypes}}, Result: Object, Pure: true, Call: geohashDecodeFunc},
+	{Name: "geohash_encode", Description: "Returns the geohash of a point with precision characters, 12 by default.",
+		Params: []Param{{"lat", pointTypes}, {"lng", numberTypes}, {"precision", numberTypes}}, Optional: 2, Result: String, Pure: true, Call: geohashEncodeFunc},
+	{Name: "group_by", Description: "Returns an object of the arrays of elements with the same value at path.",
+		Params: []Param{{"array", []Type{Array, String}}, {"path", stringTypes}}, Result: Object, Pure: true, Call: groupByFunc},
+	{Name: "hex_decode", Description: "Decodes hex text.",
+		Params: []Param{{"s", stringTypes}}, Result: Bytes, Pure: true, Call: hexDecodeFunc},
+	{Name: "hex_encode", Description: "Encodes x in hex.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: hexEncodeFunc},
+	{Name: "hmac_sha256", Description: "Returns the hex HMAC-SHA256 of data.",
+		Params: []Param{{"data", dataTypes}, {"key", payloadTypes}}, Result: String, Pure: true, Call: hmacSha256Func},
+	{Name: "hour", Description: "Returns the hour of ts in the timezone.",
+		Params: []Param{{"ts", timeTypes}, {"tz", stringTypes}}, Optional: 1, Result: Int,
+		Pure: true, Call: defaultOptionFunc(hourFunc), callWith: hourFunc},
+	{Name: "in", Description: "Reports whether x equals any of the values, a single array is searched by its elements.",
+		Params: []Param{{"x", nil}, {"values", nil}}, Variadic: true, Result: Bool, Pure: true, Call: inFunc},
+	{Name: "int32_be", Description: "Decodes a big-endian int32 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Int, Pure: true, Call: int32BEFunc},
+	{Name: "int32_le", Description: "Decodes a little-endian int32 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Int, Pure: true, Call: int32LEFunc},
+	{Name: "interval", Description: "Converts x to a Duration, a number is in milliseconds.",
+		Params: []Param{{"x", []Type{Number, String, Duration}}}, Result: Duration, Pure: true, Call: intervalFunc},
+	{Name: "join", Description: "Joins the text of the elements of array with sep.",
+		Params: []Param{{"array", []Type{Array}}, {"sep", stringTypes}}, Result: String, Pure: true, Call: joinFunc},
+	{Name: "json_delete", Description: "Returns doc without the values at the paths.",
+		Params: []Param{{"doc", docTypes}, {"path", stringTypes}}, Variadic: true, Result: JSON, Pure: true, Call: jsonDeleteFunc},
+	{Name: "json_get", Description: "Returns the value at path in doc.",
+		Params: []Param{{"doc", docTypes}, {"path", stringTypes}}, Pure: true, Call: jsonGetFunc},
+	{Name: "json_parse", Description: "Parses the json text s.",
+		Params: []Param{{"s", stringTypes}}, Pure: true, Call: jsonParseFunc},
+	{Name: "json_set", Description: "Returns doc with value set at path.",
+		Params: []Param{{"doc", docTypes}, {"path", stringTypes}, {"value", nil}}, Result: JSON, Pure: true, Call: jsonSetFunc},
+	{Name: "key_by", Description: "Returns an object of the elements keyed by the value at path.",
+		Params: []Param{{"array", []Type{Array, String}}, {"path", stringTypes}}, Result: Object, Pure: true, Call: keyByFunc},
+	{Name: "keys", Description: "Returns the array of the keys of object.",
+		Params: []Param{{"object", []Type{Object, String}}}, Result: Array, Pure: true, Call: keysFunc},
+	{Name: "length", Description: "Returns the number of characters of a string, bytes of bytes, elements of an array or keys of an object.",
+		Params: []Param{{"x", []Type{String, Bytes, Array, Object}}}, Result: Int, Pure: true, Call: lengthFunc},
+	{Name: "ln", Description: "Returns the natural logarithm of x.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: lnFunc},
+	{Name: "log10", Description: "Returns the decimal logarithm of x.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: log10Func},
+	{Name: "lower", Description: "Returns s in lower case.",
+		Params: []Param{{"s", stringTypes}}, Result: String, Pure: true, Call: lowerFunc},
+	{Name: "ltrim", Description: "Trims the leading characters of cutset from s, white space by default.",
+		Params: []Param{{"s", stringTypes}, {"cutset", stringTypes}}, Optional: 1, Result: String, Pure: true, Call: ltrimFunc},
+	{Name: "max", Description: "Returns the largest of its arguments, or of the elements of a single array.",
+		Params: []Param{{"x", []Type{Number, Array}}}, Variadic: true, Result: Number, resultOf: commonNumber, Pure: true, Call: maxFunc},
+	{Name: "md5", Description: "Returns the hex MD5 of x.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: md5Func},
+	{Name: "merge", Description: "Returns the objects merged from left to right, a later value replaces an earlier one.",
+		Params: []Param{{"object", []Type{Object, String}}}, Variadic: true, Result: Object, Pure: true, Call: mergeFunc},
+	{Name: "min", Description: "Returns the smallest of its arguments, or of the elements of a single array.",
+		Params: []Param{{"x", []Type{Number, Array}}}, Variadic: true, Result: Number, resultOf: commonNumber, Pure: true, Call: minFunc},
+	{Name: "now", Description: "Returns the time of the clock of the rule.",
+		Result: Timestamp, Call: defaultOptionFunc(nowFunc), callWith: nowFunc},
+	{Name: "pad_left", Description: "Pads s on the left with pad, a space by default, up to width characters.",
+		Params: []Param{{"s", stringTypes}, {"width", numberTypes}, {"pad", stringTypes}}, Optional: 1, Result: String, Pure: true, Call: padLeftFunc},
+	{Name: "pad_right", Description: "Pads s on the right with pad, a space by default, up to width characters.",
+		Params: []Param{{"s", stringTypes}, {"width", numberTypes}, {"pad", stringTypes}}, Optional: 1, Result: String, Pure: true, Call: padRightFunc},
+	{Name: "parse_time", Description: "Parses s by layout, ISO-8601 by default, a text without zone is read in the timezone.",
+		Params: []Param{{"s", stringTypes}, {"layout", stringTypes}, {"tz", stringTypes}}, Optional: 2, Result: Timestamp,
+		Pure: true, Call: defaultOptionFunc(parseTimeFunc), callWith: parseTimeFunc},
+	{Name: "pi", Description: "Returns the number pi.",
+		Result: Float, Pure: true, Call: piFunc},
+	{Name: "pow", Description: "Returns x**y, exact for an Int or a Decimal raised to a non-negative integer.",
+		Params: []Param{{"x", numberTypes}, {"y", numberTypes}}, Result: Number, Pure: true, Call: powFunc},
+	{Name: "prev", Description: "Returns the value of x in the previous Exec of the rule.",
+		Params: []Param{{"x", nil}}, Result: Undefined, resultOf: sameAsFirst, callState: prevFunc},
+	{Name: "rate", Description: "Returns the increase per second of counter since the previous Exec of the rule, at ts or now.",
+		Params: []Param{{"counter", numberTypes}, {"ts", timeTypes}}, Optional: 1, Result: Float, callState: rateFunc},
+	{Name: "replace", Description: "Replaces the first n occurrences of old in s by new, all of them without n.",
+		Params: []Param{{"s", stringTypes}, {"old", stringTypes}, {"new", stringTypes}, {"n", numberTypes}}, Optional: 1, Result: String, Pure: true, Call: replaceFunc},
+	{Name: "round", Description: "Rounds x half away from zero to n fractional digits, 0 by default.",
+		Params: []Param{{"x", numberTypes}, {"n", numberTypes}}, Optional: 1, Result: Number, resultOf: roundResult, Pure: true, Call: roundFunc},
+	{Name: "rtrim", Description: "Trims the trailing characters of cutset from s, white space by default.",
+		Params: []Param{{"s", stringTypes}, {"cutset", stringTypes}}, Optional: 1, Result: String, Pure: true, Call: rtrimFunc},
+	{Name: "sha1", Description: "Returns the hex SHA-1 of x.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: sha1Func},
+	{Name: "sha256", Description: "Returns the hex SHA-256 of x.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: sha256Func},
+	{Name: "sign", Description: "Returns -1, 0 or 1 by the sign of x.",
+		Params: []Param{{"x", numberTypes}}, Result: Int, Pure: true, Call: signFunc},
+	{Name: "sin", Description: "Returns the sine of x in radians.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: sinFunc},
+	{Name: "sort_by", Description: "Returns array sorted by the values at path, in 'asc' or 'desc' order.",
+		Params: []Param{{"array", []Type{Array, String}}, {"path", stringTypes}, {"order", stringTypes}}, Optional: 1, Result: Array, Pure: true, Call: sortByFunc},
+	{Name: "split", Description: "Returns the array of substrings of s separated by sep.",
+		Params: []Param{{"s", stringTypes}, {"sep", stringTypes}}, Result: Array, Pure: true, Call: splitFunc},
+	{Name: "sqrt", Description: "Returns the square root of x.",
+		Params: []Param{{"x", numberTypes}}, Result: Float, Pure: true, Call: sqrtFunc},
+	{Name: "starts_with", Description: "Reports whether s starts with prefix.",
+		Params: []Param{{"s", stringTypes}, {"prefix", stringTypes}}, Result: Bool, Pure: true, Call: startsWithFunc},
+	{Name: "substr", Description: "Returns length characters of s from start, a negative start counts from the end.",
+		Params: []Param{{"s", stringTypes}, {"start", numberTypes}, {"length", numberTypes}}, Optional: 1, Result: String, Pure: true, Call: substrFunc},
+	{Name: "timestamp", Description: "Converts x to a Timestamp, a number is an epoch in s, ms, us or ns by its magnitude, from_unixtime takes the unit.",
+		Params: []Param{{"x", []Type{Number, String, Timestamp}}}, Result: Timestamp, Pure: true, Call: timestampFunc},
+	{Name: "to_unixtime", Description: "Returns the epoch of ts in seconds, or in the unit 's', 'ms', 'us' or 'ns'.",
+		Params: []Param{{"ts", timeTypes}, {"unit", stringTypes}}, Optional: 1, Result: Int, Pure: true, Call: toUnixtimeFunc},
+	{Name: "trim", Description: "Trims the characters of cutset from both ends of s, white space by default.",
+		Params: []Param{{"s", stringTypes}, {"cutset", stringTypes}}, Optional: 1, Result: String, Pure: true, Call: trimSpaceFunc},
+	{Name: "trunc", Description: "Rounds x toward zero to n fractional digits, 0 by default.",
+		Params: []Param{{"x", numberTypes}, {"n", numberTypes}}, Optional: 1, Result: Number, resultOf: roundResult, Pure: true, Call: truncFunc},
+	{Name: "typeof", Description: "Returns the name of the type of x.",
+		Params: []Param{{"x", nil}}, Result: String, Pure: true, Call: typeofFunc},
+	{Name: "uint16_be", Description: "Decodes a big-endian uint16 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Int, Pure: true, Call: uint16BEFunc},
+	{Name: "uint16_le", Description: "Decodes a little-endian uint16 at offset, 0 by default.",
+		Params: []Param{{"payload", payloadTypes}, {"offset", numberTypes}}, Optional: 1, Result: Int, Pure: true, Call: uint16LEFunc},
+	{Name: "unpack", Description: "Decodes the fields of a frame into an object by a layout such as 'u16be:voltage,i16le:temp'.",
+		Params: []Param{{"payload", payloadTypes}, {"layout", stringTypes}}, Result: Object, Pure: true, Call: unpackFunc},
+	{Name: "upper", Description: "Returns s in upper case.",
+		Params: []Param{{"s", stringTypes}}, Result: String, Pure: true, Call: upperFunc},
+	{Name: "url_decode", Description: "Decodes url query escaping.",
+		Params: []Param{{"s", stringTypes}}, Result: String, Pure: true, Call: urlDecodeFunc},
+	{Name: "url_encode", Description: "Encodes x with url query escaping.",
+		Params: []Param{{"x", dataTypes}}, Result: String, Pure: true, Call: urlEncodeFunc},
+	{Name: "values", Description: "Returns the array of the values of an object or the elements of an array.",
+		Params: []Param{{"doc", docTypes}}, Result: Array, Pure: true, Call: valuesFunc},
+}
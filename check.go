@@ -0,0 +1,597 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+// Schema declares the types of the properties of an entity, keys are paths
+// relative to the entity, e.g. "temperature" or "location.lat".
+// The elements of an array are declared by "[]", e.g. "channels[]".
+type Schema map[string]Type
+
+var typeNames = map[string]Type{
+	"null":      Null,
+	"bool":      Bool,
+	"boolean":   Bool,
+	"number":    Number,
+	"int":       Int,
+	"integer":   Int,
+	"float":     Float,
+	"double":    Float,
+	"string":    String,
+	"json":      JSON,
+	"object":    Object,
+	"array":     Array,
+	"decimal":   Decimal,
+	"timestamp": Timestamp,
+	"duration":  Duration,
+	"bytes":     Bytes,
+}
+
+// ParseType returns the type named by s, e.g. "int" or "Timestamp".
+func ParseType(s string) (Type, bool) {
+	typ, ok := typeNames[strings.ToLower(s)]
+	return typ, ok
+}
+
+// ParseSchema parse a JSON Schema of an object, or a compact type map such as
+// {"temperature": "float", "location": {"lat": "float"}, "channels": ["int"]}.
+func ParseSchema(raw []byte) (Schema, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("tdtl: invalid schema: %w", err)
+	}
+	schema := Schema{}
+	var err error
+	if isJSONSchema(doc) {
+		err = schema.addJSONSchema("", doc)
+	} else {
+		err = schema.addCompact("", doc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func isJSONSchema(doc map[string]interface{}) bool {
+	if _, ok := doc["$schema"]; ok {
+		return true
+	}
+	if _, ok := doc["properties"].(map[string]interface{}); ok {
+		return true
+	}
+	return doc["type"] == "object"
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func (s Schema) addCompact(path string, v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		typ, ok := ParseType(v)
+		if !ok {
+			return fmt.Errorf("tdtl: unknown type %q of %q in schema", v, path)
+		}
+		s[path] = typ
+	case map[string]interface{}:
+		if path != "" {
+			s[path] = Object
+		}
+		for key, sub := range v {
+			if err := s.addCompact(joinPath(path, key), sub); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		s[path] = Array
+		if len(v) == 1 {
+			return s.addCompact(path+"[]", v[0])
+		}
+	default:
+		return fmt.Errorf("tdtl: invalid type of %q in schema", path)
+	}
+	return nil
+}
+
+func (s Schema) addJSONSchema(path string, doc map[string]interface{}) error {
+	typ := Undefined
+	switch name := doc["type"].(type) {
+	case string:
+		switch format, _ := doc["format"].(string); {
+		case name == "string" && format == "date-time":
+			typ = Timestamp
+		case name == "string" && format == "duration":
+			typ = Duration
+		case name == "string" && format == "byte":
+			typ = Bytes
+		default:
+			t, ok := ParseType(name)
+			if !ok {
+				return fmt.Errorf("tdtl: unknown type %q of %q in schema", name, path)
+			}
+			typ = t
+		}
+	case nil:
+		if _, ok := doc["properties"]; ok {
+			typ = Object
+		}
+	}
+	if path != "" && typ != Undefined {
+		s[path] = typ
+	}
+
+	if props, ok := doc["properties"].(map[string]interface{}); ok {
+		for key, sub := range props {
+			sub, ok := sub.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("tdtl: invalid schema of %q", joinPath(path, key))
+			}
+			if err := s.addJSONSchema(joinPath(path, key), sub); err != nil {
+				return err
+			}
+		}
+	}
+	if items, ok := doc["items"].(map[string]interface{}); ok {
+		return s.addJSONSchema(path+"[]", items)
+	}
+	return nil
+}
+
+var indexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// lookup returns the declared type of path, an undeclared path below an
+// object or array without declared members is Undefined, ok is false if the
+// path is not declared at all.
+func (s Schema) lookup(path string) (Type, bool) {
+	path = indexPattern.ReplaceAllString(path, "[]")
+	if typ, ok := s[path]; ok {
+		return typ, true
+	}
+	for i := strings.LastIndexAny(path, ".["); i > 0; i = strings.LastIndexAny(path[:i], ".[") {
+		prefix := path[:i]
+		typ, ok := s[prefix]
+		if !ok {
+			continue
+		}
+		if !typ.IsContainer() {
+			return Undefined, false
+		}
+		for key := range s {
+			if strings.HasPrefix(key, prefix+".") || strings.HasPrefix(key, prefix+"[") {
+				// the members of the prefix are declared, path is not one of them.
+				return Undefined, false
+			}
+		}
+		return Undefined, true
+	}
+	return Undefined, false
+}
+
+// TypeError is a type mismatch found by Check.
+type TypeError struct {
+	Pos  Pos
+	Expr string
+	Msg  string
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("[%s]%s: %s", e.Pos, e.Expr, e.Msg)
+}
+
+// TypeErrors are the type errors of a rule, in the order of the fields.
+type TypeErrors []*TypeError
+
+func (e TypeErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func sameAsFirst(args []Type) Type {
+	return args[0]
+}
+
+// roundResult returns the type of the rounding functions,
+// a Float rounded to n digits is a Decimal which keeps them.
+func roundResult(args []Type) Type {
+	if len(args) == 2 && args[0] == Float {
+		return Decimal
+	}
+	return args[0]
+}
+
+// bytesAtResult returns the type of bytes_at, a byte or a slice of bytes.
+func bytesAtResult(args []Type) Type {
+	if len(args) == 3 {
+		return Bytes
+	}
+	return Int
+}
+
+// convertResult returns the type of convert, a Decimal for a decimal value.
+func convertResult(args []Type) Type {
+	if args[0] == Decimal {
+		return Decimal
+	}
+	return Float
+}
+
+// commonNumber returns the type shared by the numeric arguments, Number if they differ.
+func commonNumber(args []Type) Type {
+	if typ := commonType(args); typ.IsNumber() {
+		return typ
+	}
+	return Number
+}
+
+// checkTypes infers the type of each field of expr from the schemas of the entities
+// and the signatures of the functions, a type which cannot be inferred is Undefined.
+// The mismatches are returned as TypeErrors.
+func checkTypes(expr Expr, schemas map[string]Schema, extFunc map[string]ContextFunc, o *options) (map[string]Type, error) {
+	c := &checker{schemas: schemas, extFunc: extFunc, options: o, undefinedCalls: true}
+	types := map[string]Type{}
+	switch expr := expr.(type) {
+	case *SelectStatementExpr:
+		for _, field := range expr.fields {
+			types[field.alias] = c.infer(field.exp)
+		}
+		if expr.filter != nil && expr.filter.exp != nil {
+			c.expect(expr.filter.exp, Bool)
+		}
+	case *FieldExpr:
+		types[expr.alias] = c.infer(expr.exp)
+	default:
+		types[""] = c.infer(expr)
+	}
+	if len(c.errs) > 0 {
+		return types, c.errs
+	}
+	return types, nil
+}
+
+// checkCalls checks every call of expr found by ParseFunc against the signature
+// of its function, a function which is not declared is undefined unless the options
+// leave it to the context. Without schemas the arguments are typed by their literals
+// and by the results of the nested calls, the other arguments are not checked.
+func checkCalls(expr Expr, extFunc map[string]ContextFunc, o *options) error {
+	c := &checker{extFunc: extFunc, options: o, undefinedCalls: !o.runtimeFunctions}
+	for _, call := range ParseFunc(expr) {
+		// the nested calls are checked in their turn, their errors are not reported twice.
+		quiet := &checker{extFunc: extFunc, options: o}
+		args := make([]Type, len(call.args))
+		for i, arg := range call.args {
+			args[i] = quiet.infer(arg)
+		}
+		c.checkCall(call, args)
+	}
+	if len(c.errs) > 0 {
+		return c.errs
+	}
+	return nil
+}
+
+type checker struct {
+	schemas map[string]Schema
+	extFunc map[string]ContextFunc
+	options *options
+	// undefinedCalls reports the calls of functions which are neither declared
+	// nor in extFunc, unless WithRuntimeFunctions leaves them to the context.
+	undefinedCalls bool
+	errs           TypeErrors
+}
+
+func (c *checker) errorf(pos Pos, expr Expr, format string, a ...interface{}) {
+	c.errs = append(c.errs, &TypeError{
+		Pos:  pos,
+		Expr: exprString(expr),
+		Msg:  fmt.Sprintf(format, a...),
+	})
+}
+
+func (c *checker) expect(expr Expr, typ Type) {
+	if got := c.infer(expr); got != Undefined && typeKind(got) != typ {
+		c.errorf(exprPos(expr), expr, "expected %s, found %s", typ, got)
+	}
+}
+
+// infer returns the type of expr, Undefined if it is unknown.
+func (c *checker) infer(expr Expr) Type {
+	switch expr := expr.(type) {
+	case Node:
+		return expr.Type()
+	case *JSONPathExpr:
+		return c.inferPath(expr)
+	case *BinaryExpr:
+		return c.inferBinary(expr)
+	case *CallExpr:
+		return c.inferCall(expr)
+	case *SwitchExpr:
+		return c.inferSwitch(expr)
+	case *InExpr:
+		c.infer(expr.exp)
+		for _, x := range expr.list {
+			c.infer(x)
+		}
+		return Bool
+	}
+	return Undefined
+}
+
+func (c *checker) inferPath(expr *JSONPathExpr) Type {
+	entity, rest := expr.val, ""
+	if i := strings.IndexAny(expr.val, ".["); i != -1 {
+		entity, rest = expr.val[:i], strings.TrimPrefix(expr.val[i:], ".")
+	}
+	schema, ok := c.schemas[entity]
+	if !ok || strings.Contains(rest, "*") {
+		return Undefined
+	}
+	if rest == "" {
+		return Object
+	}
+	typ, ok := schema.lookup(rest)
+	if !ok {
+		c.errorf(expr.pos, expr, "%s is not declared in the schema of %s", rest, entity)
+	}
+	return typ
+}
+
+func (c *checker) inferBinary(expr *BinaryExpr) Type {
+	lhs, rhs := Type(Bool), c.infer(expr.RHS)
+	if expr.LHS != nil {
+		lhs = c.infer(expr.LHS)
+	}
+	typ, ok := binaryType(expr.Op, lhs, rhs)
+	if !ok {
+		c.errorf(expr.pos, expr, "mismatched types %s %s %s", lhs, opNames[expr.Op], rhs)
+	}
+	return typ
+}
+
+// typeKind returns the kind of typ as compared by operators, all numbers are Number.
+func typeKind(typ Type) Type {
+	if typ.IsNumber() {
+		return Number
+	}
+	return typ
+}
+
+// binaryType returns the result type of op, ok is false if op is not defined
+// on the operands without an implicit conversion.
+func binaryType(op int, lhs, rhs Type) (Type, bool) {
+	lk, rk := typeKind(lhs), typeKind(rhs)
+	switch {
+	case isLogicOP(op):
+		return Bool, (lk == Bool || lk == Undefined) && (rk == Bool || rk == Undefined)
+	case !isArithmeticOP(op):
+		return Bool, lk == rk || lk == Undefined || rk == Undefined ||
+			lk == Null || rk == Null || lk.IsContainer() || rk.IsContainer()
+	case lk == Object || rk == Object:
+		return Undefined, false
+	case lk == Array || rk == Array || lk == JSON || rk == JSON:
+		return Array, true
+	case lk == Undefined || rk == Undefined || lk == Null || rk == Null:
+		return Undefined, true
+	case !strictArithmetic(op, lk, rk):
+		return Undefined, false
+	}
+
+	switch {
+	case lk == Number && rk == Number:
+		return numberType(lhs, rhs), true
+	case lk == Timestamp && rk == Timestamp:
+		return Duration, true
+	case lk == Timestamp || rk == Timestamp:
+		return Timestamp, true
+	case lk == Duration && rk == Duration && op == parser.TDTLParserDIV:
+		return Float, true
+	case lk == Duration || rk == Duration:
+		return Duration, true
+	}
+	return lhs, true
+}
+
+// numberType returns the type of arithmetic on two numbers.
+func numberType(lhs, rhs Type) Type {
+	switch {
+	case lhs == Decimal || rhs == Decimal:
+		return Decimal
+	case lhs == Number || rhs == Number:
+		return Number
+	case lhs == Float || rhs == Float:
+		return Float
+	}
+	return Int
+}
+
+func (c *checker) inferCall(expr *CallExpr) Type {
+	args := make([]Type, len(expr.args))
+	for i, arg := range expr.args {
+		args[i] = c.infer(arg)
+	}
+	return c.checkCall(expr, args)
+}
+
+// checkCall checks the arity of expr and the types args of its arguments against
+// the signature of the function. A function of extFunc comes first and has no
+// signature, like a function which only the context resolves at runtime.
+func (c *checker) checkCall(expr *CallExpr, args []Type) Type {
+	if _, ok := c.extFunc[expr.key]; ok {
+		return Undefined
+	}
+	fn := c.options.lookupFunction(expr.key)
+	if fn == nil {
+		if c.undefinedCalls {
+			c.errorf(expr.pos, expr, "undefined function %s", expr.key)
+		}
+		return Undefined
+	}
+
+	min, max := len(fn.Params)-fn.Optional, len(fn.Params)
+	if len(args) < min || (!fn.Variadic && len(args) > max) {
+		c.errorf(expr.pos, expr, "%s expects %s arguments, found %d", expr.key, argCount(min, max, fn.Variadic), len(args))
+		return Undefined
+	}
+	for i, arg := range args {
+		params := fn.Params[len(fn.Params)-1].Types
+		if i < len(fn.Params) {
+			params = fn.Params[i].Types
+		}
+		if params == nil || arg == Undefined || acceptsKind(params, typeKind(arg)) {
+			continue
+		}
+		c.errorf(exprPos(expr.args[i]), expr.args[i], "argument %d of %s must be %s, found %s", i+1, expr.key, kindNames(params), arg)
+	}
+	return fn.resultType(args)
+}
+
+func acceptsKind(kinds []Type, kind Type) bool {
+	for _, k := range kinds {
+		if k == kind || (k.IsContainer() && kind == JSON) {
+			return true
+		}
+	}
+	return false
+}
+
+func kindNames(kinds []Type) string {
+	names := make([]string, len(kinds))
+	for i, k := range kinds {
+		names[i] = k.String()
+	}
+	return strings.Join(names, " or ")
+}
+
+func argCount(min, max int, variadic bool) string {
+	switch {
+	case variadic:
+		return fmt.Sprintf("at least %d", min)
+	case min == max:
+		return fmt.Sprintf("%d", min)
+	}
+	return fmt.Sprintf("%d to %d", min, max)
+}
+
+func (c *checker) inferSwitch(expr *SwitchExpr) Type {
+	value := c.infer(expr.exp)
+	results := make([]Type, 0, len(expr.list)+1)
+	for _, e := range expr.list {
+		when := c.infer(e.when)
+		if _, ok := binaryType(parser.TDTLParserEQ, value, when); !ok {
+			c.errorf(exprPos(e.when), e.when, "mismatched types CASE %s WHEN %s", value, when)
+		}
+		results = append(results, c.infer(e.then))
+	}
+	if expr.last != nil {
+		results = append(results, c.infer(expr.last))
+	}
+	return commonType(results)
+}
+
+// commonType returns the type shared by types, Number for mixed numbers, otherwise Undefined.
+func commonType(types []Type) Type {
+	if len(types) == 0 {
+		return Undefined
+	}
+	ret := types[0]
+	for _, typ := range types[1:] {
+		switch {
+		case typ == ret:
+		case typ.IsNumber() && ret.IsNumber():
+			ret = Number
+		default:
+			return Undefined
+		}
+	}
+	return ret
+}
+
+// exprPos returns the position of expr, literals have no position.
+func exprPos(expr Expr) Pos {
+	switch expr := expr.(type) {
+	case *JSONPathExpr:
+		return expr.pos
+	case *BinaryExpr:
+		if expr.LHS != nil {
+			if pos := exprPos(expr.LHS); pos != (Pos{}) {
+				return pos
+			}
+		}
+		return expr.pos
+	case *CallExpr:
+		return expr.pos
+	case *SwitchExpr:
+		return expr.pos
+	case *InExpr:
+		if pos := exprPos(expr.exp); pos != (Pos{}) {
+			return pos
+		}
+		return expr.pos
+	}
+	return Pos{}
+}
+
+// exprString returns the text of expr for error messages.
+func exprString(expr Expr) string {
+	switch expr := expr.(type) {
+	case StringNode:
+		return string(quoteJSON(string(expr)))
+	case Node:
+		return expr.String()
+	case *JSONPathExpr:
+		return expr.val
+	case *BinaryExpr:
+		if expr.LHS == nil {
+			return opNames[expr.Op] + " " + exprString(expr.RHS)
+		}
+		return exprString(expr.LHS) + " " + opNames[expr.Op] + " " + exprString(expr.RHS)
+	case *CallExpr:
+		args := make([]string, len(expr.args))
+		for i, arg := range expr.args {
+			args[i] = exprString(arg)
+		}
+		return expr.key + "(" + strings.Join(args, ", ") + ")"
+	case *SwitchExpr:
+		return "CASE " + exprString(expr.exp)
+	case *InExpr:
+		list := make([]string, len(expr.list))
+		for i, x := range expr.list {
+			list[i] = exprString(x)
+		}
+		op := " IN ("
+		if expr.not {
+			op = " NOT IN ("
+		}
+		return exprString(expr.exp) + op + strings.Join(list, ", ") + ")"
+	}
+	return ""
+}
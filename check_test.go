@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchema(t *testing.T) {
+	compact, err := ParseSchema([]byte(`{
+		"temperature": "float", "name": "string",
+		"location": {"lat": "double"}, "channels": ["int"]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Schema{
+		"temperature":  Float,
+		"name":         String,
+		"location":     Object,
+		"location.lat": Float,
+		"channels":     Array,
+		"channels[]":   Int,
+	}, compact)
+
+	schema, err := ParseSchema([]byte(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "object",
+		"properties": {
+			"temperature": {"type": "number"},
+			"name": {"type": "string"},
+			"ts": {"type": "string", "format": "date-time"},
+			"location": {"type": "object", "properties": {"lat": {"type": "number"}}},
+			"channels": {"type": "array", "items": {"type": "integer"}}
+		}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Schema{
+		"temperature":  Number,
+		"name":         String,
+		"ts":           Timestamp,
+		"location":     Object,
+		"location.lat": Number,
+		"channels":     Array,
+		"channels[]":   Int,
+	}, schema)
+
+	_, err = ParseSchema([]byte(`{"a": "complex"}`))
+	assert.Error(t, err)
+	_, err = ParseSchema([]byte(`[`))
+	assert.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	schemas := map[string]Schema{
+		"entity1": {"temperature": Float, "name": String, "ts": Timestamp, "meta": JSON,
+			"location": Object, "location.lat": Float, "channels": Array, "channels[]": Int},
+	}
+	tests := []struct {
+		name string
+		sql  string
+		want map[string]Type
+		errs []string
+	}{
+		{"arithmetic", `insert into target select entity1.temperature * 2 as t, entity1.channels[0] + 1 as c`,
+			map[string]Type{"t": Float, "c": Int}, nil},
+		{"functions", `insert into target select abs(entity1.temperature) as a, typeof(entity1.name) as b, entity1.ts - entity1.ts as d`,
+			map[string]Type{"a": Float, "b": String, "d": Duration}, nil},
+		{"rounding", `insert into target select round(entity1.temperature) as r, round(entity1.temperature, 2) as r2, floor(entity1.channels[0], 1) as f`,
+			map[string]Type{"r": Float, "r2": Decimal, "f": Int}, nil},
+		{"unknown", `insert into target select entity1.meta.x as x, entity2.y + 1 as y, entity1.location.lat > 1 as z`,
+			map[string]Type{"x": Undefined, "y": Undefined, "z": Bool}, nil},
+		{"mismatch", `insert into target select entity1.temperature + 'abc' as t, abs(entity1.name) as a`,
+			map[string]Type{"t": Undefined, "a": String},
+			[]string{
+				"[1:46]entity1.temperature + \"abc\": mismatched types Float + String",
+				"[1:64]entity1.name: argument 1 of abs must be Number, found String",
+			}},
+		{"undeclared", `insert into target select entity1.humidity as h, foo(1) as f, round(1, 2, 3) as r`,
+			map[string]Type{"h": Undefined, "f": Undefined, "r": Undefined},
+			[]string{
+				"[1:26]entity1.humidity: humidity is not declared in the schema of entity1",
+				"[1:49]foo(1): undefined function foo",
+				"[1:62]round(1, 2, 3): round expects 1 to 2 arguments, found 3",
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// NewTDTL rejects the undefined function, Check the parsed rule.
+			expr, err := Parse(tt.sql)
+			assert.NoError(t, err)
+			types, err := checkTypes(expr, schemas, nil, nil)
+			assert.Equal(t, tt.want, types)
+			if tt.errs == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var msgs []string
+			for _, e := range err.(TypeErrors) {
+				msgs = append(msgs, e.Error())
+			}
+			assert.Equal(t, tt.errs, msgs)
+		})
+	}
+}
+
+func TestChecker(t *testing.T) {
+	tql, err := NewTDTL(`insert into target select entity1.temperature * 2 as t`, nil)
+	assert.NoError(t, err)
+	checker, ok := tql.(Checker)
+	assert.True(t, ok)
+	types, err := checker.Check(map[string]Schema{"entity1": {"temperature": Float}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Type{"t": Float}, types)
+}
+
+func TestNewTDTLWithSchema(t *testing.T) {
+	schema := Schema{"temperature": Float}
+	_, err := NewTDTL(`insert into target select entity1.temperature + 1 as t`, nil, WithSchema("entity1", schema))
+	assert.NoError(t, err)
+
+	_, err = NewTDTL(`insert into target select entity1.temperature + 'abc' as t`, nil, WithSchema("entity1", schema))
+	assert.EqualError(t, err, "[1:46]entity1.temperature + \"abc\": mismatched types Float + String")
+}
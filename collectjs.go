@@ -49,7 +49,7 @@ func NewString(raw string) *Collect {
 }
 
 func NewFloat64(raw float64) *Collect {
-	str := fmt.Sprintf("%.6f", raw)
+	str := formatFloat(raw)
 	return &Collect{
 		value:    []byte(str),
 		datatype: Float,
@@ -65,7 +65,7 @@ func newCollect(data []byte) *Collect {
 	if _, jtype, _, err := jsonparser.Get(data); err == nil {
 		collect.datatype = datetype(jtype)
 		if collect.datatype == String {
-			collect.value = collect.value[1 : len(collect.value)-1]
+			collect.value = unescapeString(collect.value[1 : len(collect.value)-1])
 		}
 	} else {
 		collect.err = err
@@ -73,6 +73,19 @@ func newCollect(data []byte) *Collect {
 	return collect
 }
 
+// unescapeString returns the text of the content of a json string,
+// the value of a String collect is always unescaped.
+func unescapeString(raw []byte) []byte {
+	if bytes.IndexByte(raw, '\\') == -1 {
+		return raw
+	}
+	str, err := jsonparser.ParseString(raw)
+	if err != nil {
+		return raw
+	}
+	return []byte(str)
+}
+
 func newCollectFromJsonResult(ret Result) *Collect {
 	collect := &Collect{}
 	collect.path = ""
@@ -91,6 +104,9 @@ func newCollectFromJsonparserResult(dataType jsonparser.ValueType, value []byte)
 	collect.path = ""
 	collect.value = []byte(value)
 	collect.datatype = datetype(dataType)
+	if collect.datatype == String {
+		collect.value = unescapeString(collect.value)
+	}
 	return collect
 }
 
@@ -116,6 +132,11 @@ func (cc *Collect) Set(path string, value Node) {
 	cc.value, cc.err = set(cc.value, path, value.Raw())
 }
 
+// SetBytes set raw at path as the hinted text of a BytesNode.
+func (cc *Collect) SetBytes(path string, raw []byte) {
+	cc.Set(path, BytesNode(raw))
+}
+
 func (cc *Collect) Append(path string, value Node) {
 	cc.value, cc.err = add(cc.value, path, value.Raw())
 	if errors.Is(cc.err, jsonparser.KeyPathNotFoundError) {
@@ -236,7 +257,7 @@ func (cc *Collect) Merge(mc *Collect) *Collect {
 		cc.err = errors.New("datatype is not object")
 		return cc
 	}
-	if cc.datatype == Null {
+	if cc.datatype == Null || cc.datatype == Undefined {
 		return mc
 	}
 
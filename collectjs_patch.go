@@ -9,6 +9,9 @@ import (
 )
 
 func _gjson2JsonNode(ret gjson.Result) Node {
+	if !ret.Exists() {
+		return UNDEFINED_RESULT
+	}
 	switch ret.Type {
 	case gjson.True:
 		return BoolNode(true)
@@ -25,7 +28,7 @@ func _gjson2JsonNode(ret gjson.Result) Node {
 	case gjson.JSON:
 		return JSONNode{
 			value:    []byte(ret.Raw),
-			datatype: JSON,
+			datatype: datetype(ret),
 		}
 	}
 	return NULL_RESULT
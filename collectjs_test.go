@@ -25,7 +25,8 @@ func TestCollect_New(t *testing.T) {
 		{"2", "cpu", NewString("1"), `{"cpu":"1"}`},
 		{"2", "cpu", NewBool(true), `{"cpu":true}`},
 		{"2", "cpu", NewInt64(3456), `{"cpu":3456}`},
-		{"2", "cpu", NewFloat64(1 / 3.0), `{"cpu":0.333333}`},
+		{"2", "cpu", NewFloat64(1 / 3.0), `{"cpu":0.3333333333333333}`},
+		{"2", "cpu", NewFloat64(50.1), `{"cpu":50.1}`},
 	}
 	for _, tt := range tests {
 		got := New("{}")
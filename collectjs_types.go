@@ -17,10 +17,14 @@ package tdtl
 
 import (
 	"fmt"
-	"github.com/tkeel-io/tdtl/pkg/json/gjson"
-	"github.com/tkeel-io/tdtl/pkg/json/jsonparser"
+	"math"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+	"github.com/tkeel-io/tdtl/pkg/json/jsonparser"
 )
 
 type MapHandle func(key []byte, value *Collect) Node
@@ -29,7 +33,7 @@ type SortHandle func(p1 *Collect, p2 *Collect) bool
 
 var (
 	UNDEFINED_RESULT = &JSONNode{datatype: Undefined}
-	NULL_RESULT      = &JSONNode{datatype: Undefined}
+	NULL_RESULT      = &JSONNode{value: []byte("null"), datatype: Null}
 )
 
 // result represents a json value that is returned from Get().
@@ -60,6 +64,14 @@ const (
 	Object
 	// Array is a type of JSON
 	Array
+	// Decimal is an arbitrary-precision json number
+	Decimal
+	// Timestamp is a point in time, encoded as an ISO-8601 json string
+	Timestamp
+	// Duration is an elapsed time, encoded as a json string such as "5m0s"
+	Duration
+	// Bytes is a binary payload, encoded as a hinted json string such as "base64:AAEC"
+	Bytes
 )
 
 // String returns a string representation of the type.
@@ -71,6 +83,8 @@ func (t Type) String() string {
 		return "Null"
 	case Bool:
 		return "Bool"
+	case Number:
+		return "Number"
 	case Int:
 		return "Int"
 	case Float:
@@ -79,18 +93,48 @@ func (t Type) String() string {
 		return "String"
 	case JSON:
 		return "JSON"
+	case Object:
+		return "Object"
+	case Array:
+		return "Array"
+	case Decimal:
+		return "Decimal"
+	case Timestamp:
+		return "Timestamp"
+	case Duration:
+		return "Duration"
+	case Bytes:
+		return "Bytes"
+	}
+}
+
+// IsNumber reports whether the type is Number, Int, Float or Decimal.
+func (t Type) IsNumber() bool {
+	switch t {
+	case Number, Int, Float, Decimal:
+		return true
+	}
+	return false
+}
+
+// IsContainer reports whether the type is JSON, Object or Array.
+func (t Type) IsContainer() bool {
+	switch t {
+	case JSON, Object, Array:
+		return true
 	}
+	return false
 }
 
 var jsonparserDatetype = map[jsonparser.ValueType]Type{
-	jsonparser.NotExist: Null,
+	jsonparser.NotExist: Undefined,
 	jsonparser.String:   String,
 	jsonparser.Number:   Number,
 	jsonparser.Object:   Object,
 	jsonparser.Array:    Array,
 	jsonparser.Boolean:  Bool,
 	jsonparser.Null:     Null,
-	jsonparser.Unknown:  Null,
+	jsonparser.Unknown:  Undefined,
 }
 
 var gjsonDatetype = map[gjson.Type]Type{
@@ -107,18 +151,32 @@ func datetype(data interface{}) Type {
 	case jsonparser.ValueType:
 		return jsonparserDatetype[data]
 	case gjson.Result:
+		if !data.Exists() {
+			return Undefined
+		}
 		typ := gjsonDatetype[data.Type]
 		if typ == JSON {
-			if data.IsArray() {
-				return Array
-			}
-			if data.IsObject() {
-				return Object
-			}
+			return containerType([]byte(data.Raw))
 		}
 		return typ
 	}
-	return Null
+	return Undefined
+}
+
+// containerType resolve JSON block to Object or Array by the first token.
+func containerType(raw []byte) Type {
+	for _, c := range raw {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '{':
+			return Object
+		case '[':
+			return Array
+		}
+		return JSON
+	}
+	return JSON
 }
 
 // True is a json true boolean
@@ -164,6 +222,12 @@ func (r IntNode) To(typ Type) Node {
 		return r
 	case Float:
 		return FloatNode(r)
+	case Decimal:
+		return NewDecimalFromInt(int64(r))
+	case Timestamp:
+		return EpochTimestamp(int64(r))
+	case Duration:
+		return DurationNode(int64(r) * int64(time.Millisecond))
 	case String:
 		return StringNode(fmt.Sprintf("%d", r))
 	}
@@ -185,9 +249,24 @@ func (r FloatNode) To(typ Type) Node {
 	case Number, Float:
 		return r
 	case Int:
-		return IntNode(r)
+		// float64(math.MaxInt64) rounds up to 2^63, so the upper bound is exclusive.
+		if r >= -(1<<63) && r < 1<<63 {
+			return IntNode(r)
+		}
+		return errorNode(ErrIntegerOverflow)
+	case Decimal:
+		if d, err := NewDecimalFromFloat(float64(r)); err == nil {
+			return d
+		}
+	case Timestamp:
+		if ts, ok := epochFloatTimestamp(float64(r)); ok {
+			return ts
+		}
+		return errorNode(ErrIntegerOverflow)
+	case Duration:
+		return DurationNode(float64(r) * float64(time.Millisecond))
 	case String:
-		return StringNode(fmt.Sprintf("%f", r))
+		return StringNode(r.String())
 	}
 	return UNDEFINED_RESULT
 }
@@ -195,7 +274,38 @@ func (r FloatNode) Raw() []byte {
 	return []byte(r.String())
 }
 func (r FloatNode) String() string {
-	return fmt.Sprintf("%.6f", r)
+	return formatFloat(float64(r))
+}
+
+// formatFloat formats f in the shortest representation that round-trips,
+// using exponent notation only for very large or very small magnitudes.
+func formatFloat(f float64) string {
+	abs, format := math.Abs(f), byte('f')
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	str := strconv.FormatFloat(f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(str); n >= 4 && str[n-4] == 'e' && str[n-3] == '-' && str[n-2] == '0' {
+			str = str[:n-2] + str[n-1:]
+		}
+	}
+	return str
+}
+
+// roundFloat rounds f to n fractional digits by mode, on its shortest decimal representation,
+// so round(2.675, 2) is 2.68 as written rather than as stored. The result is a decimal which
+// keeps the n digits, round(2.5, 2) is 2.50. NaN and infinities are returned as they are.
+func roundFloat(f float64, n int, mode RoundingMode) Node {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return FloatNode(f)
+	}
+	d, err := NewDecimalFromFloat(f)
+	if err != nil {
+		return FloatNode(f)
+	}
+	return d.Round(int32(n), mode)
 }
 
 type StringNode string
@@ -229,16 +339,65 @@ func (r StringNode) To(typ Type) Node {
 			return UNDEFINED_RESULT
 		}
 		return FloatNode(b)
+	case Decimal:
+		d, err := NewDecimal(string(r))
+		if err != nil {
+			return UNDEFINED_RESULT
+		}
+		return d
+	case Timestamp:
+		t, err := ParseTimestamp(string(r))
+		if err != nil {
+			return UNDEFINED_RESULT
+		}
+		return t
+	case Duration:
+		d, err := ParseDuration(string(r))
+		if err != nil {
+			return UNDEFINED_RESULT
+		}
+		return d
+	case Bytes:
+		if b, ok := stringBytes(string(r)); ok {
+			return b
+		}
+		return UNDEFINED_RESULT
 	}
 	return UNDEFINED_RESULT
 }
 func (r StringNode) Raw() []byte {
-	return []byte(fmt.Sprintf("\"%s\"", r))
+	return quoteJSON(string(r))
 }
 func (r StringNode) String() string {
 	return string(r)
 }
 
+// quoteJSON returns s as a json string, invalid utf-8 is replaced by U+FFFD.
+func quoteJSON(s string) []byte {
+	const hexDigits = "0123456789abcdef"
+	var runeBuf [utf8.UTFMax]byte
+	buf := make([]byte, 0, len(s)+2)
+	buf = append(buf, '"')
+	for _, c := range s {
+		switch {
+		case c == '"' || c == '\\':
+			buf = append(buf, '\\', byte(c))
+		case c == '\n':
+			buf = append(buf, '\\', 'n')
+		case c == '\r':
+			buf = append(buf, '\\', 'r')
+		case c == '\t':
+			buf = append(buf, '\\', 't')
+		case c < 0x20:
+			buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+		default:
+			n := utf8.EncodeRune(runeBuf[:], c)
+			buf = append(buf, runeBuf[:n]...)
+		}
+	}
+	return append(buf, '"')
+}
+
 // JSONNode maybe Object or Array
 type JSONNode struct {
 	value    []byte
@@ -252,8 +411,20 @@ func (r JSONNode) Type() Type   { return r.datatype }
 func (r JSONNode) Error() error { return r.err }
 func (cc JSONNode) To(typ Type) Node {
 	switch typ {
-	case JSON, Object, Array:
-		return cc
+	case JSON:
+		if cc.datatype.IsContainer() {
+			return cc
+		}
+		return UNDEFINED_RESULT
+	case Object, Array:
+		datatype := cc.datatype
+		if datatype == JSON {
+			datatype = containerType(cc.value)
+		}
+		if datatype == typ {
+			return cc
+		}
+		return UNDEFINED_RESULT
 	case Bool:
 		return cc.To(String).To(Bool)
 	case Number:
@@ -262,23 +433,32 @@ func (cc JSONNode) To(typ Type) Node {
 		return cc.To(String).To(Int)
 	case Float:
 		return cc.To(String).To(Float)
+	case Decimal:
+		return cc.To(String).To(Decimal)
+	case Timestamp:
+		return cc.To(String).To(Timestamp)
+	case Duration:
+		return cc.To(String).To(Duration)
+	case Bytes:
+		if cc.datatype == String {
+			return cc.To(String).To(Bytes)
+		}
+		return UNDEFINED_RESULT
 	case String:
 		return StringNode(cc.String())
 	case Null:
-		return UNDEFINED_RESULT
-	case Undefined:
+		if cc.datatype == Null {
+			return NULL_RESULT
+		}
 		return UNDEFINED_RESULT
 	default:
 		return UNDEFINED_RESULT
 	}
-	return UNDEFINED_RESULT
 }
 func (r JSONNode) Raw() []byte {
 	switch r.datatype {
 	case String:
-		ret := append([]byte{byte('"')}, r.value...)
-		ret = append(ret, byte('"'))
-		return ret
+		return quoteJSON(string(r.value))
 	default:
 		return []byte(r.String())
 	}
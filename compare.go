@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/tkeel-io/tdtl/parser"
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+)
+
+// Equal reports whether a and b are deeply equal, objects are compared
+// regardless of key order, arrays element-wise and numbers by value,
+// so 1, 1.0 and decimal '1.00' are equal.
+func Equal(a, b Node) bool {
+	return Compare(a, b) == 0
+}
+
+// Compare returns -1, 0 or +1 by a total ordering of nodes.
+// Nodes of different kinds are ordered by kind:
+// Undefined < Null < Bool < Number < String < Timestamp < Duration < Bytes < Array < Object.
+// Arrays are ordered element-wise, objects by their members sorted by key.
+// The ordering operators of a rule use it only for nodes of the same kind.
+func Compare(a, b Node) int {
+	a, b = structuralNode(a), structuralNode(b)
+	ka, kb := compareKind(a), compareKind(b)
+	if ka != kb {
+		return compareInt(int64(kindOrder[ka]), int64(kindOrder[kb]))
+	}
+	switch ka {
+	case Bool:
+		return compareBool(a.(BoolNode), b.(BoolNode))
+	case Number:
+		return compareNumber(a, b)
+	case String:
+		return strings.Compare(a.String(), b.String())
+	case Timestamp:
+		return compareInt(a.(TimestampNode).Time().UnixNano(), b.(TimestampNode).Time().UnixNano())
+	case Duration:
+		return compareInt(int64(a.(DurationNode)), int64(b.(DurationNode)))
+	case Bytes:
+		return bytes.Compare(a.(BytesNode), b.(BytesNode))
+	case Array:
+		return compareArray(a.(JSONNode), b.(JSONNode))
+	case Object:
+		return compareObject(a.(JSONNode), b.(JSONNode))
+	}
+	return 0
+}
+
+// structuralNode dereference json node, json scalars are converted to simple node.
+func structuralNode(node Node) Node {
+	if node == nil {
+		return UNDEFINED_RESULT
+	}
+	if cc, ok := node.(*JSONNode); ok {
+		if cc == nil {
+			return UNDEFINED_RESULT
+		}
+		node = *cc
+	}
+	cc, ok := node.(JSONNode)
+	if !ok {
+		return node
+	}
+	switch cc.datatype {
+	case Undefined:
+		return UNDEFINED_RESULT
+	case Null:
+		return NULL_RESULT
+	case Object, Array:
+		return cc
+	case JSON:
+		cc.datatype = containerType(cc.value)
+		return cc
+	case Number:
+		if d, err := NewDecimal(cc.String()); err == nil {
+			return d
+		}
+	}
+	return cc.Node()
+}
+
+// compareKind returns the kind of node used to order nodes of different types.
+func compareKind(node Node) Type {
+	switch typ := node.Type(); typ {
+	case Number, Int, Float, Decimal:
+		return Number
+	case Bool, String, Timestamp, Duration, Bytes, Null, Array, Object:
+		return typ
+	case JSON:
+		return containerType(node.Raw())
+	}
+	return Undefined
+}
+
+// order of kinds, a kind missing here sorts first.
+var kindOrder = map[Type]int{
+	Null:      1,
+	Bool:      2,
+	Number:    3,
+	String:    4,
+	Timestamp: 5,
+	Duration:  6,
+	Bytes:     7,
+	Array:     8,
+	Object:    9,
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareBool(a, b BoolNode) int {
+	switch {
+	case a == b:
+		return 0
+	case !bool(a):
+		return -1
+	}
+	return 1
+}
+
+// compareNumber compares numbers exactly as decimals, NaN sorts before any other number.
+func compareNumber(a, b Node) int {
+	da, okA := toDecimal(a)
+	db, okB := toDecimal(b)
+	if okA && okB {
+		return da.Cmp(db)
+	}
+	fa, _ := a.To(Float).(FloatNode)
+	fb, _ := b.To(Float).(FloatNode)
+	nanA, nanB := math.IsNaN(float64(fa)), math.IsNaN(float64(fb))
+	switch {
+	case nanA || nanB:
+		return compareBool(BoolNode(!nanA), BoolNode(!nanB))
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
+
+type member struct {
+	key   string
+	value Node
+}
+
+// members returns the members of an object or the elements of an array.
+func members(cc JSONNode) []member {
+	ret := make([]member, 0)
+	gjson.ParseBytes(cc.value).ForEach(func(key, value gjson.Result) bool {
+		ret = append(ret, member{key: key.String(), value: resultNode(value)})
+		return true
+	})
+	return ret
+}
+
+// resultNode converts json result to node, numbers are kept exact as decimals.
+func resultNode(ret gjson.Result) Node {
+	if ret.Type == gjson.Number {
+		if d, err := NewDecimal(ret.Raw); err == nil {
+			return d
+		}
+	}
+	return structuralNode(_gjson2JsonNode(ret))
+}
+
+func compareArray(a, b JSONNode) int {
+	ma, mb := members(a), members(b)
+	for i := 0; i < len(ma) && i < len(mb); i++ {
+		if ret := Compare(ma[i].value, mb[i].value); ret != 0 {
+			return ret
+		}
+	}
+	return compareInt(int64(len(ma)), int64(len(mb)))
+}
+
+func compareObject(a, b JSONNode) int {
+	ma, mb := members(a), members(b)
+	sortMembers(ma)
+	sortMembers(mb)
+	for i := 0; i < len(ma) && i < len(mb); i++ {
+		if ret := strings.Compare(ma[i].key, mb[i].key); ret != 0 {
+			return ret
+		}
+		if ret := Compare(ma[i].value, mb[i].value); ret != 0 {
+			return ret
+		}
+	}
+	return compareInt(int64(len(ma)), int64(len(mb)))
+}
+
+func sortMembers(m []member) {
+	sort.SliceStable(m, func(i, j int) bool {
+		return m[i].key < m[j].key
+	})
+}
+
+// isStructural reports whether node is an object or array.
+func isStructural(node Node) bool {
+	switch node := node.(type) {
+	case JSONNode:
+		return node.datatype.IsContainer()
+	case *JSONNode:
+		return node != nil && node.datatype.IsContainer()
+	}
+	return false
+}
+
+// evalBinaryStructural eval comparison of an object or array with any node,
+// the ordering of an object or array with a node of another kind is undefined.
+func evalBinaryStructural(op int, lhs, rhs Node) Node {
+	switch op {
+	case parser.TDTLLexerLT, parser.TDTLLexerLTE, parser.TDTLLexerGT, parser.TDTLLexerGTE:
+		if compareKind(structuralNode(lhs)) != compareKind(structuralNode(rhs)) {
+			return UNDEFINED_RESULT
+		}
+	}
+	switch op {
+	case parser.TDTLLexerEQ:
+		return BoolNode(Equal(lhs, rhs))
+	case parser.TDTLLexerNE:
+		return BoolNode(!Equal(lhs, rhs))
+	case parser.TDTLLexerLT:
+		return BoolNode(Compare(lhs, rhs) < 0)
+	case parser.TDTLLexerLTE:
+		return BoolNode(Compare(lhs, rhs) <= 0)
+	case parser.TDTLLexerGT:
+		return BoolNode(Compare(lhs, rhs) > 0)
+	case parser.TDTLLexerGTE:
+		return BoolNode(Compare(lhs, rhs) >= 0)
+	}
+	return UNDEFINED_RESULT
+}
+
+// Less is a SortHandle ordering collects by Compare.
+func Less(p1 *Collect, p2 *Collect) bool {
+	return Compare(p1, p2) < 0
+}
+
+// LessBy returns a SortHandle ordering collects by Compare of the value at path.
+func LessBy(path string) SortHandle {
+	return func(p1 *Collect, p2 *Collect) bool {
+		return Compare(p1.Get(path), p2.Get(path)) < 0
+	}
+}
+
+// inFunc reports whether the first argument equals any of the rest,
+// a single array argument is searched by its elements.
+var inFunc = func(args ...Node) Node {
+	if len(args) < 2 {
+		return UNDEFINED_RESULT
+	}
+	x, list := args[0], args[1:]
+	if len(list) == 1 && compareKind(structuralNode(list[0])) == Array {
+		for _, m := range members(structuralNode(list[0]).(JSONNode)) {
+			if Equal(x, m.value) {
+				return BoolNode(true)
+			}
+		}
+		return BoolNode(false)
+	}
+	for _, v := range list {
+		if Equal(x, v) {
+			return BoolNode(true)
+		}
+	}
+	return BoolNode(false)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b Node
+		want bool
+	}{
+		{New(`{"x":1,"y":[1,2]}`), New(`{"y":[1,2.0],"x":1.00}`), true},
+		{New(`{"x":1}`).Node(), New(`{"x":1}`), true},
+		{New(`{"x":1}`), New(`{"x":1,"y":2}`), false},
+		{New(`{"x":"1"}`), New(`{"x":1}`), false},
+		{New(`[1,2,3]`), New(`[1, 2, 3]`), true},
+		{New(`[1,2,3]`), New(`[3,2,1]`), false},
+		{New(`[{"a":null}]`), New(`[{"a":null}]`), true},
+		{New(`[1e3]`), New(`[1000]`), true},
+		{IntNode(1), FloatNode(1), true},
+		{IntNode(1), mustDecimal("1.00"), true},
+		{IntNode(1), StringNode("1"), false},
+		{BytesNode("ab"), BytesNode("ab"), true},
+		{New(`[]`), New(`{}`), false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Equal(tt.a, tt.b), "%s = %s", tt.a, tt.b)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// ascending by kind, then by value.
+	nodes := []Node{
+		UNDEFINED_RESULT,
+		NULL_RESULT,
+		BoolNode(false),
+		BoolNode(true),
+		IntNode(-1),
+		FloatNode(0.5),
+		mustDecimal("2"),
+		StringNode("a"),
+		StringNode("b"),
+		BytesNode{0x01},
+		New(`[]`),
+		New(`[1]`),
+		New(`[1,2]`),
+		New(`[2]`),
+		New(`{}`),
+		New(`{"a":1}`),
+		New(`{"a":2}`),
+		New(`{"b":0}`),
+	}
+	for i := range nodes {
+		for j := range nodes {
+			want := compareInt(int64(i), int64(j))
+			assert.Equal(t, want, Compare(nodes[i], nodes[j]), "%d: %s, %d: %s", i, nodes[i], j, nodes[j])
+		}
+	}
+}
+
+func TestStructuralEval(t *testing.T) {
+	ctx := NewJSONContext(`{
+		"entity":  {"location": {"lat": 30.5, "lng": 120}, "tags": ["a", "b"]},
+		"entity2": {"location": {"lng": 120.0, "lat": 30.50}, "tags": ["b", "a"]}
+	}`)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`entity.location = entity2.location`, "true"},
+		{`entity.location <> entity2.location`, "false"},
+		{`entity.tags = entity2.tags`, "false"},
+		{`entity.tags < entity2.tags`, "true"},
+		{`entity.tags = 'a'`, "false"},
+		{`entity.tags > 'a'`, ""},
+		{`1 < entity.location`, ""},
+		{`entity.location >= entity2.location`, "true"},
+		{`in(entity.location, entity2.location, 1)`, "true"},
+		{`in('b', entity.tags)`, "true"},
+		{`in('c', entity.tags)`, "false"},
+		{`in(1, 1.0, 2)`, "true"},
+		{`entity.location IN (entity2.location, 1)`, "true"},
+		{`'b' IN (entity.tags)`, "true"},
+		{`'c' in (entity.tags)`, "false"},
+		{`'c' NOT IN ('a', 'b')`, "true"},
+		{`1 + 1 IN (1.0, 2.0) = true`, "true"},
+		{`in('b', entity.tags) AND 'a' IN (entity2.tags)`, "true"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, EvalRuleQL(ctx, expr).String(), tt.expr)
+	}
+
+	expr, err := ParseExpr(` CASE entity.location WHEN entity2.location THEN 'same' ELSE 'moved'`)
+	assert.NoError(t, err)
+	assert.Equal(t, "same", eval(MutilContext{DefaultValue, ctx}, expr).String())
+}
+
+func TestSortByLess(t *testing.T) {
+	cc := New(`[3, "a", {"b":1}, null, [1], 1.5, true]`)
+	cc.SortBy(Less)
+	assert.Equal(t, `[null,true,1.5,3,"a",[1],{"b":1}]`, cc.String())
+
+	cc = New(`[{"v":2},{"v":1.5},{"v":10}]`)
+	cc.SortBy(LessBy("v"))
+	assert.Equal(t, `[{"v":1.5},{"v":2},{"v":10}]`, cc.String())
+}
@@ -15,11 +15,6 @@ limitations under the License.
 */
 package tdtl
 
-import (
-	"encoding/base64"
-	"fmt"
-)
-
 //ContextValuable  eval context
 type ContextValuable interface {
 	Value(key string) Node
@@ -44,77 +39,26 @@ type Context interface {
 //ContextFunc eval context function
 type ContextFunc func(args ...Node) Node
 
-//DefaultValue default eval context
-var DefaultValue = &value{
-	functions: map[string]ContextFunc{
-		"abs":    absFunc,
-		"base64": base64Func,
-	},
-}
+// ContextFuncE is a function which reports its failure as an error, it is called
+// with the evaluation context, e.g. to read other values of the input.
+type ContextFuncE func(ctx Context, args ...Node) (Node, error)
 
-var absFunc = func(args ...Node) Node {
-	if len(args) == 1 {
-		arg := args[0]
-		switch arg := arg.(type) {
-		case IntNode:
-			return absFuncHandle(arg)
-		case FloatNode:
-			return absFuncHandle(arg)
-		case StringNode:
-			return absFuncHandle(arg.To(Number))
-		}
-	}
-	return UNDEFINED_RESULT
-}
-
-var absFuncHandle = func(args ...Node) Node {
-	if len(args) == 1 {
-		arg := args[0]
-		switch arg := arg.(type) {
-		case IntNode:
-			if int64(arg) < 0 {
-				return -1 * arg
-			}
-			return arg
-		case FloatNode:
-			if int64(arg) < 0 {
-				return -1 * arg
-			}
-			return arg
-		}
-	}
-	return UNDEFINED_RESULT
-}
+//DefaultValue default eval context
+var DefaultValue = &value{functions: Builtins}
 
-var base64Func = func(args ...Node) Node {
-	if len(args) < 1 {
+var typeofFunc = func(args ...Node) Node {
+	if len(args) != 1 {
 		return UNDEFINED_RESULT
 	}
-	arg := args[0]
-	node := ""
-	switch arg := arg.(type) {
-	case JSONNode:
-		node = arg.String()
-	case StringNode:
-		node = arg.String()
-	default:
-		fmt.Println(
-			"[-]ruleql type error",
-		)
-		return UNDEFINED_RESULT
+	typ := args[0].Type()
+	if typ == JSON {
+		typ = containerType(args[0].Raw())
 	}
-	if node == "" {
-		fmt.Println(
-			"[-]ruleql empty error",
-		)
-		return UNDEFINED_RESULT
-	}
-	decodeBytes := base64.StdEncoding.EncodeToString([]byte(node))
-	return StringNode(decodeBytes)
+	return StringNode(typ.String())
 }
 
 type value struct {
-	functions map[string]ContextFunc
+	functions *FunctionRegistry
 }
 
 func (v *value) Value(key string) Node {
@@ -122,11 +66,8 @@ func (v *value) Value(key string) Node {
 }
 
 func (v *value) Call(expr *CallExpr, args []Node) Node {
-	if v.functions == nil {
-		return UNDEFINED_RESULT
-	}
-	if fn, ok := v.functions[expr.key]; ok {
-		return fn(args...)
+	if fn := v.functions.lookup(expr.key); fn != nil {
+		return fn.call(v, defaultOptions, expr, args)
 	}
 
 	return UNDEFINED_RESULT
@@ -151,7 +92,8 @@ func (mc MutilContext) Value(key string) Node {
 func (mc MutilContext) Call(expr *CallExpr, args []Node) Node {
 	for _, v := range mc {
 		r := v.Call(expr, args)
-		if r.Type() != Undefined {
+		// an error result stops the lookup, it is not a missing function.
+		if r.Type() != Undefined || r.Error() != nil {
 			return r
 		}
 	}
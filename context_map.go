@@ -15,6 +15,11 @@ limitations under the License.
 */
 package tdtl
 
+import (
+	"strconv"
+	"strings"
+)
+
 type mapContext struct {
 	values    map[string]Node
 	functions map[string]ContextFunc
@@ -46,9 +51,37 @@ func (c mapContext) Value(key string) Node {
 			return ret
 		}
 	}
+	// resolve the rest of the path in the value of the longest prefix,
+	// e.g. "dev.temp" in {"dev": {"temp": 1}} or "payload[2]" in bytes.
+	for i := strings.LastIndexAny(key, ".["); i > 0; i = strings.LastIndexAny(key[:i], ".[") {
+		ret, ok := c.values[key[:i]]
+		if !ok {
+			continue
+		}
+		switch ret := ret.(type) {
+		case BytesNode:
+			if idx, ok := parseIndex(key[i:]); ok {
+				return ret.Index(idx)
+			}
+		case JSONNode:
+			return ret.Get(key[i:]).Node()
+		case *JSONNode:
+			return ret.Get(key[i:]).Node()
+		}
+		return UNDEFINED_RESULT
+	}
 	return UNDEFINED_RESULT
 }
 
+// parseIndex parse "[2]" into 2.
+func parseIndex(s string) (int, bool) {
+	if len(s) < 3 || s[0] != '[' || s[len(s)-1] != ']' {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(s[1 : len(s)-1])
+	return idx, err == nil
+}
+
 //Call call function from context
 func (c mapContext) Call(expr *CallExpr, args []Node) Node {
 	if ret, ok := c.functions[expr.key]; ok {
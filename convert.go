@@ -0,0 +1,558 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+)
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	durationType    = reflect.TypeOf(time.Duration(0))
+	nodeType        = reflect.TypeOf((*Node)(nil)).Elem()
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	jsonNumberType  = reflect.TypeOf(json.Number(""))
+)
+
+// FromGo converts a native Go value to Node.
+//
+// bool, integers, floats and strings become simple nodes, []byte becomes
+// BytesNode, time.Time and time.Duration become TimestampNode and DurationNode,
+// maps with string keys, structs, slices and arrays become json objects and arrays.
+// Struct fields are named by the "tdtl" tag, then the "json" tag, then the field name,
+// "-" skips a field and ",omitempty" skips a zero field.
+// A value implementing json.Marshaler is converted from its json.
+// An unsupported value, such as a channel or a function, is UNDEFINED_RESULT.
+func FromGo(v interface{}) Node {
+	if v == nil {
+		return NULL_RESULT
+	}
+	if node, ok := v.(Node); ok {
+		return node
+	}
+	return fromValue(reflect.ValueOf(v))
+}
+
+func fromValue(rv reflect.Value) Node {
+	if !rv.IsValid() {
+		return NULL_RESULT
+	}
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return NULL_RESULT
+		}
+		return fromValue(rv.Elem())
+	}
+	// a promoted field of an unexported embedded struct can only be read by kind.
+	if rv.CanInterface() {
+		if node, ok := fromInterface(rv); ok {
+			return node
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return NULL_RESULT
+		}
+		return fromValue(rv.Elem())
+	case reflect.Bool:
+		return BoolNode(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return IntNode(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return DecimalNode{unscaled: new(big.Int).SetUint64(u)}
+		}
+		return IntNode(u)
+	case reflect.Float32:
+		// keep the shortest representation of float32, 0.1 rather than 0.10000000149011612.
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(rv.Float(), 'g', -1, 32), 64)
+		return FloatNode(f)
+	case reflect.Float64:
+		return FloatNode(rv.Float())
+	case reflect.String:
+		return StringNode(rv.String())
+	case reflect.Slice:
+		if rv.IsNil() {
+			return NULL_RESULT
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return BytesNode(rv.Bytes())
+		}
+		return fromArray(rv)
+	case reflect.Array:
+		return fromArray(rv)
+	case reflect.Map:
+		if rv.IsNil() {
+			return NULL_RESULT
+		}
+		return fromMap(rv)
+	case reflect.Struct:
+		return fromStruct(rv)
+	}
+	return UNDEFINED_RESULT
+}
+
+// fromInterface converts the types which are not decided by kind.
+func fromInterface(rv reflect.Value) (Node, bool) {
+	switch rv.Type() {
+	case timeType:
+		return TimestampNode(rv.Interface().(time.Time).UTC()), true
+	case durationType:
+		return DurationNode(rv.Int()), true
+	case jsonNumberType:
+		return StringNode(rv.String()).To(Number), true
+	}
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, false
+	}
+	if rv.Type().Implements(nodeType) {
+		return rv.Interface().(Node), true
+	}
+	if rv.Type().Implements(marshalerType) {
+		raw, err := rv.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return errorNode(err), true
+		}
+		return New(raw).Node(), true
+	}
+	return nil, false
+}
+
+func fromArray(rv reflect.Value) Node {
+	buf := bytes.NewBufferString("[")
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		node := fromValue(rv.Index(i))
+		if node.Error() != nil {
+			return node
+		}
+		buf.Write(rawOrNull(node))
+	}
+	buf.WriteByte(']')
+	return JSONNode{value: buf.Bytes(), datatype: Array}
+}
+
+func fromMap(rv reflect.Value) Node {
+	if rv.Type().Key().Kind() != reflect.String {
+		return UNDEFINED_RESULT
+	}
+	keys := make([]string, 0, rv.Len())
+	for _, k := range rv.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBufferString("{")
+	for i, key := range keys {
+		node := fromValue(rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key())))
+		if node.Error() != nil {
+			return node
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(quoteJSON(key))
+		buf.WriteByte(':')
+		buf.Write(rawOrNull(node))
+	}
+	buf.WriteByte('}')
+	return JSONNode{value: buf.Bytes(), datatype: Object}
+}
+
+func fromStruct(rv reflect.Value) Node {
+	buf := bytes.NewBufferString("{")
+	n := 0
+	for _, f := range structFields(rv.Type()) {
+		fv, ok := fieldByIndex(rv, f.index)
+		if !ok || (f.omitempty && fv.IsZero()) {
+			continue
+		}
+		node := fromValue(fv)
+		if node.Error() != nil {
+			return node
+		}
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(quoteJSON(f.name))
+		buf.WriteByte(':')
+		buf.Write(rawOrNull(node))
+		n++
+	}
+	buf.WriteByte('}')
+	return JSONNode{value: buf.Bytes(), datatype: Object}
+}
+
+// rawOrNull returns the json of node, undefined is written as null.
+func rawOrNull(node Node) []byte {
+	if node.Type() == Undefined {
+		return []byte("null")
+	}
+	return node.Raw()
+}
+
+type structField struct {
+	name      string
+	index     []int
+	omitempty bool
+}
+
+// structFields returns the fields of a struct type, fields of an untagged
+// embedded struct are promoted unless the outer struct has the same name.
+func structFields(t reflect.Type) []structField {
+	fields := make([]structField, 0, t.NumField())
+	names := map[string]bool{}
+	var promoted []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("tdtl")
+		if tag == "" {
+			tag = sf.Tag.Get("json")
+		}
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.IndexByte(tag, ','); idx != -1 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct && ft != timeType {
+			for _, f := range structFields(ft) {
+				f.index = append([]int{i}, f.index...)
+				promoted = append(promoted, f)
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		names[name] = true
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			omitempty: strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+	for _, f := range promoted {
+		if !names[f.name] {
+			names[f.name] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// fieldByIndex returns the nested field, ok is false if it is behind a nil pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, idx := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(idx)
+	}
+	return rv, true
+}
+
+// ToGo converts node to a native Go value.
+//
+// Objects become map[string]interface{}, arrays []interface{}, integers int64,
+// floats float64, decimals json.Number, timestamps time.Time, durations time.Duration,
+// bytes []byte, and null or undefined nil.
+func ToGo(node Node) interface{} {
+	switch node := structuralNode(node).(type) {
+	case BoolNode:
+		return bool(node)
+	case IntNode:
+		return int64(node)
+	case FloatNode:
+		return float64(node)
+	case StringNode:
+		return string(node)
+	case DecimalNode:
+		return json.Number(node.String())
+	case TimestampNode:
+		return node.Time()
+	case DurationNode:
+		return node.Duration()
+	case BytesNode:
+		return []byte(node)
+	case JSONNode:
+		switch node.datatype {
+		case Object:
+			ret := map[string]interface{}{}
+			eachElement(node, func(key string, value Node) {
+				ret[key] = ToGo(value)
+			})
+			return ret
+		case Array:
+			ret := make([]interface{}, 0)
+			eachElement(node, func(_ string, value Node) {
+				ret = append(ret, ToGo(value))
+			})
+			return ret
+		}
+	}
+	return nil
+}
+
+// eachElement calls fn with the members of an object or the elements of an array.
+func eachElement(cc JSONNode, fn func(key string, value Node)) {
+	gjson.ParseBytes(cc.value).ForEach(func(key, value gjson.Result) bool {
+		fn(key.String(), elementNode(value))
+		return true
+	})
+}
+
+// Decode stores node in the value pointed to by out, the reverse of FromGo.
+// Null and undefined leave the zero value, numbers and strings are converted
+// to the kind of the target as by Node.To.
+func Decode(node Node, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("%w: non-nil pointer required, got %T", ErrDecode, out)
+	}
+	if err := node.Error(); err != nil {
+		return err
+	}
+	return decodeValue(node, rv.Elem())
+}
+
+func decodeValue(node Node, rv reflect.Value) error {
+	node = structuralNode(node)
+	if typ := node.Type(); typ == Undefined || typ == Null {
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+
+	switch rv.Type() {
+	case timeType:
+		return decodeSet(node, Timestamp, rv, func(n Node) reflect.Value {
+			return reflect.ValueOf(n.(TimestampNode).Time())
+		})
+	case durationType:
+		return decodeSet(node, Duration, rv, func(n Node) reflect.Value {
+			return reflect.ValueOf(n.(DurationNode).Duration())
+		})
+	case nodeType:
+		rv.Set(reflect.ValueOf(node))
+		return nil
+	}
+	if rv.Kind() != reflect.Ptr && rv.CanAddr() && rv.Addr().Type().Implements(unmarshalerType) {
+		return rv.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(node.Raw())
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return decodeValue(node, rv.Elem())
+	case reflect.Interface:
+		if rv.NumMethod() != 0 {
+			return decodeError(node, rv)
+		}
+		if v := ToGo(node); v != nil {
+			rv.Set(reflect.ValueOf(v))
+		}
+		return nil
+	case reflect.Bool:
+		return decodeSet(node, Bool, rv, func(n Node) reflect.Value {
+			return reflect.ValueOf(bool(n.(BoolNode))).Convert(rv.Type())
+		})
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := node.To(Int).(IntNode)
+		if !ok || rv.OverflowInt(int64(i)) {
+			return decodeError(node, rv)
+		}
+		rv.SetInt(int64(i))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if d, ok := node.To(Decimal).(DecimalNode); ok {
+			u := d.Round(0, RoundDown).value()
+			if u.Sign() >= 0 && u.IsUint64() && !rv.OverflowUint(u.Uint64()) {
+				rv.SetUint(u.Uint64())
+				return nil
+			}
+		}
+		return decodeError(node, rv)
+	case reflect.Float32, reflect.Float64:
+		f, ok := node.To(Float).(FloatNode)
+		if !ok || rv.OverflowFloat(float64(f)) {
+			return decodeError(node, rv)
+		}
+		rv.SetFloat(float64(f))
+		return nil
+	case reflect.String:
+		if node.Type() == Object || node.Type() == Array || node.Type() == JSON {
+			return decodeError(node, rv)
+		}
+		rv.SetString(node.String())
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			if b, ok := node.To(Bytes).(BytesNode); ok {
+				rv.SetBytes(append([]byte{}, b...))
+				return nil
+			}
+		}
+		cc, ok := node.(JSONNode)
+		if !ok || cc.datatype != Array {
+			return decodeError(node, rv)
+		}
+		elems, _ := arrayElements(cc)
+		slice := reflect.MakeSlice(rv.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := decodeValue(elem, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+		return nil
+	case reflect.Array:
+		cc, ok := node.(JSONNode)
+		if !ok || cc.datatype != Array {
+			return decodeError(node, rv)
+		}
+		elems, _ := arrayElements(cc)
+		for i := 0; i < rv.Len(); i++ {
+			if i >= len(elems) {
+				rv.Index(i).Set(reflect.Zero(rv.Type().Elem()))
+				continue
+			}
+			if err := decodeValue(elems[i], rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		cc, ok := node.(JSONNode)
+		if !ok || cc.datatype != Object || rv.Type().Key().Kind() != reflect.String {
+			return decodeError(node, rv)
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+		var err error
+		eachElement(cc, func(key string, value Node) {
+			if err != nil {
+				return
+			}
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			if err = decodeValue(value, elem); err == nil {
+				rv.SetMapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()), elem)
+			}
+		})
+		return err
+	case reflect.Struct:
+		cc, ok := node.(JSONNode)
+		if !ok || cc.datatype != Object {
+			return decodeError(node, rv)
+		}
+		fields := structFields(rv.Type())
+		var err error
+		eachElement(cc, func(key string, value Node) {
+			if err != nil {
+				return
+			}
+			f, ok := lookupField(fields, key)
+			if !ok {
+				return
+			}
+			var field reflect.Value
+			if field, err = allocFieldByIndex(rv, f.index); err == nil {
+				err = decodeValue(value, field)
+			}
+		})
+		return err
+	}
+	return decodeError(node, rv)
+}
+
+// decodeSet converts node to typ and sets the value made by fn.
+func decodeSet(node Node, typ Type, rv reflect.Value, fn func(Node) reflect.Value) error {
+	n := node.To(typ)
+	if n.Type() != typ {
+		return decodeError(node, rv)
+	}
+	rv.Set(fn(n))
+	return nil
+}
+
+func decodeError(node Node, rv reflect.Value) error {
+	return fmt.Errorf("%w: cannot decode %s %s into %s", ErrDecode, node.Type(), node.String(), rv.Type())
+}
+
+// lookupField finds the field named key, an exact match is preferred over a case-insensitive one.
+func lookupField(fields []structField, key string) (structField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return structField{}, false
+}
+
+// allocFieldByIndex returns the nested field, nil embedded pointers are allocated.
+// As in encoding/json, a nil embedded pointer to an unexported struct cannot be allocated.
+func allocFieldByIndex(rv reflect.Value, index []int) (reflect.Value, error) {
+	for i, idx := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				if !rv.CanSet() {
+					return rv, fmt.Errorf("%w: cannot set embedded pointer to unexported struct %s", ErrDecode, rv.Type().Elem())
+				}
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(idx)
+	}
+	return rv, nil
+}
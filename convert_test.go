@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type convertBase struct {
+	ID string `json:"id"`
+}
+
+type convertDevice struct {
+	convertBase
+	Name     string            `tdtl:"name"`
+	Temp     float64           `json:"temp"`
+	Count    uint8             `json:"count,omitempty"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Time     time.Time         `json:"time"`
+	Interval time.Duration     `json:"interval"`
+	Payload  []byte            `json:"payload"`
+	Parent   *convertDevice    `json:"parent"`
+	Ignored  string            `json:"-"`
+	private  int
+}
+
+func TestFromGo(t *testing.T) {
+	ts := time.Date(2018, 4, 23, 1, 58, 42, 0, time.UTC)
+	tests := []struct {
+		value interface{}
+		want  Node
+	}{
+		{nil, NULL_RESULT},
+		{true, BoolNode(true)},
+		{int8(-3), IntNode(-3)},
+		{uint32(7), IntNode(7)},
+		{float32(0.1), FloatNode(0.1)},
+		{1.5, FloatNode(1.5)},
+		{"abc", StringNode("abc")},
+		{[]byte{1, 2}, BytesNode{1, 2}},
+		{ts, TimestampNode(ts)},
+		{time.Minute, DurationNode(time.Minute)},
+		{json.Number("12"), IntNode(12)},
+		{IntNode(1), IntNode(1)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, FromGo(tt.value), "%v", tt.value)
+	}
+
+	assert.Equal(t, "18446744073709551615", FromGo(uint64(math.MaxUint64)).String())
+	assert.Equal(t, `[1,"a",null]`, FromGo([]interface{}{1, "a", nil}).String())
+	assert.Equal(t, `{"a":1,"b":[true]}`, FromGo(map[string]interface{}{"b": []bool{true}, "a": 1}).String())
+	assert.Equal(t, `{"k":"v"}`, FromGo(json.RawMessage(`{"k":"v"}`)).String())
+	assert.Equal(t, Undefined, FromGo(make(chan int)).Type())
+	assert.Equal(t, Undefined, FromGo(map[int]int{1: 1}).Type())
+
+	dev := convertDevice{
+		convertBase: convertBase{ID: "d1"},
+		Name:        "sensor",
+		Temp:        21.5,
+		Tags:        []string{"a"},
+		Time:        ts,
+		Interval:    5 * time.Second,
+		Payload:     []byte{0xff},
+		Ignored:     "x",
+	}
+	want := `{"name":"sensor","temp":21.5,"tags":["a"],"time":"2018-04-23T01:58:42Z",` +
+		`"interval":"5s","payload":"base64:/w==","parent":null,"id":"d1"}`
+	node := FromGo(&dev)
+	assert.Equal(t, Object, node.Type())
+	assert.Equal(t, want, node.String())
+}
+
+func TestToGo(t *testing.T) {
+	assert.Nil(t, ToGo(UNDEFINED_RESULT))
+	assert.Nil(t, ToGo(NULL_RESULT))
+	assert.Equal(t, int64(1), ToGo(IntNode(1)))
+	assert.Equal(t, json.Number("1.10"), ToGo(mustDecimal("1.10")))
+	assert.Equal(t, []byte{1}, ToGo(BytesNode{1}))
+	assert.Equal(t, map[string]interface{}{
+		"a": int64(1),
+		"b": []interface{}{1.5, "x", nil, true},
+		"c": map[string]interface{}{},
+	}, ToGo(New(`{"a":1,"b":[1.5,"x",null,true],"c":{}}`)))
+	assert.Equal(t, "x", ToGo(New(`{"a":"x"}`).Get("a")))
+}
+
+func TestDecode(t *testing.T) {
+	ts := time.Date(2018, 4, 23, 1, 58, 42, 0, time.UTC)
+	var dev convertDevice
+	err := Decode(New(`{
+		"id": "d1", "NAME": "sensor", "temp": "21.5", "count": 3,
+		"tags": ["a", "b"], "labels": {"k": "v"},
+		"time": 1524448722000, "interval": "5s", "payload": "hex:ff",
+		"parent": {"name": "gw"}, "unknown": 1
+	}`), &dev)
+	assert.NoError(t, err)
+	assert.Equal(t, convertDevice{
+		convertBase: convertBase{ID: "d1"},
+		Name:        "sensor",
+		Temp:        21.5,
+		Count:       3,
+		Tags:        []string{"a", "b"},
+		Labels:      map[string]string{"k": "v"},
+		Time:        ts,
+		Interval:    5 * time.Second,
+		Payload:     []byte{0xff},
+		Parent:      &convertDevice{Name: "gw"},
+	}, dev)
+
+	// round trip.
+	var back convertDevice
+	assert.NoError(t, Decode(FromGo(dev), &back))
+	assert.Equal(t, dev, back)
+
+	var m map[string]interface{}
+	assert.NoError(t, Decode(New(`{"a":[1]}`), &m))
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{int64(1)}}, m)
+
+	var n Node
+	assert.NoError(t, Decode(IntNode(2), &n))
+	assert.Equal(t, IntNode(2), n)
+
+	var small int8
+	assert.True(t, errors.Is(Decode(IntNode(300), &small), ErrDecode))
+	assert.True(t, errors.Is(Decode(StringNode("x"), &small), ErrDecode))
+	var count uint
+	assert.True(t, errors.Is(Decode(IntNode(-1), &count), ErrDecode))
+	assert.True(t, errors.Is(Decode(IntNode(1), dev), ErrDecode))
+	assert.True(t, errors.Is(Decode(errorNode(ErrDivisionByZero), &small), ErrDivisionByZero))
+
+	// a nil embedded pointer to an unexported struct cannot be allocated, as in encoding/json.
+	embedded := struct {
+		*convertBase
+		Temp float64 `json:"temp"`
+	}{}
+	assert.True(t, errors.Is(Decode(New(`{"temp": 1, "id": "d1"}`), &embedded), ErrDecode))
+	embedded.convertBase = &convertBase{}
+	assert.NoError(t, Decode(New(`{"temp": 1, "id": "d1"}`), &embedded))
+	assert.Equal(t, "d1", embedded.ID)
+	assert.Equal(t, 1.0, embedded.Temp)
+}
+
+func TestExecFromGo(t *testing.T) {
+	tql, err := NewTDTL(`insert into target select dev.temp * 2 as double, dev.name as name`, nil)
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{
+		"dev": FromGo(map[string]interface{}{"temp": 21.5, "name": "sensor"}),
+	})
+	assert.NoError(t, err)
+
+	var out struct {
+		Double float64 `json:"double"`
+		Name   string  `json:"name"`
+	}
+	assert.NoError(t, Decode(FromGo(ret), &out))
+	assert.Equal(t, 43.0, out.Double)
+	assert.Equal(t, "sensor", out.Name)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrDivisionByZero is reported by division and modulo with a zero divisor.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrIntegerOverflow is reported when an integer result does not fit in int64.
+	ErrIntegerOverflow = errors.New("integer overflow")
+	// ErrLengthMismatch is reported by element-wise arithmetic on arrays of different lengths.
+	ErrLengthMismatch = errors.New("array length mismatch")
+	// ErrTypeMismatch is reported in StrictMode by an operator on operands of different types.
+	ErrTypeMismatch = errors.New("type mismatch")
+	// ErrOutOfDomain is reported by a math function whose argument is outside its domain, e.g. sqrt(-1).
+	ErrOutOfDomain = errors.New("argument out of domain")
+	// ErrInvalidArgument is reported by a function called with an argument of a type it does not accept.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrInvalidEncoding is reported by a decoding function on malformed input, e.g. hex_decode('xyz').
+	ErrInvalidEncoding = errors.New("invalid encoding")
+	// ErrOutOfRange is reported by reading past the end of a frame or an integer.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrUnknownUnit is reported by convert for a unit which is not registered.
+	ErrUnknownUnit = errors.New("unknown unit")
+	// ErrIncompatibleUnits is reported by convert between units of different dimensions.
+	ErrIncompatibleUnits = errors.New("incompatible units")
+	// ErrDecode is reported by Decode when a node does not fit the target.
+	ErrDecode = errors.New("decode error")
+	// ErrRecursion is reported by a function created in TQL which calls itself.
+	ErrRecursion = errors.New("recursive call")
+)
+
+// errorNode returns an undefined result which carries err.
+func errorNode(err error) Node {
+	return &JSONNode{datatype: Undefined, err: err}
+}
+
+// EvalError is an error of the evaluation of a sub-expression, the call or
+// the operator where the error was first reported.
+type EvalError struct {
+	Pos  Pos
+	Expr string
+	Err  error
+}
+
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("[%s]%s: %v", e.Pos, e.Expr, e.Err)
+}
+
+func (e *EvalError) Unwrap() error {
+	return e.Err
+}
+
+// exprError returns ret, an error of ret is attributed to expr unless
+// a sub-expression of expr already reported it.
+func exprError(expr Expr, ret Node) Node {
+	err := ret.Error()
+	if err == nil || ret.Type() != Undefined {
+		return ret
+	}
+	var evalErr *EvalError
+	if errors.As(err, &evalErr) {
+		return ret
+	}
+	return errorNode(&EvalError{Pos: exprPos(expr), Expr: exprString(expr), Err: err})
+}
+
+// FieldError is the error of a field of a rule.
+type FieldError struct {
+	Field string
+	// Expr is the failing sub-expression of the field.
+	Expr *EvalError
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Expr)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Expr
+}
+
+// FieldErrors are the errors of the fields of a rule, in the order of the fields.
+// errors.Is and errors.As match any of them.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e FieldErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e FieldErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldError returns the error of field, err is the error of the evaluation of expr.
+func fieldError(field string, expr Expr, err error) *FieldError {
+	var evalErr *EvalError
+	if !errors.As(err, &evalErr) {
+		evalErr = &EvalError{Pos: exprPos(expr), Expr: exprString(expr), Err: err}
+	}
+	return &FieldError{Field: field, Expr: evalErr}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestExecFieldErrors(t *testing.T) {
+	tql, err := NewTDTL(`insert into target select dev.name as name, round(sqrt(dev.x) + 1, 2) as root,
+		dev.total / dev.count as avg`, nil)
+	assert.NoError(t, err)
+
+	ret, err := tql.Exec(map[string]Node{
+		"dev.name":  StringNode("pump"),
+		"dev.x":     IntNode(-4),
+		"dev.total": IntNode(10),
+		"dev.count": IntNode(0),
+	})
+	assert.Equal(t, "pump", ret["name"].String())
+	assert.Equal(t, Undefined, ret["root"].Type())
+	assert.Equal(t, Undefined, ret["avg"].Type())
+	assert.EqualError(t, err, "root: [1:50]sqrt(dev.x): argument out of domain\n"+
+		"avg: [2:2]dev.total / dev.count: division by zero")
+
+	var errs FieldErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "root", errs[0].Field)
+	assert.Equal(t, "sqrt(dev.x)", errs[0].Expr.Expr)
+	assert.Equal(t, Pos{Line: 2, Column: 2}, errs[1].Expr.Pos)
+	assert.True(t, errors.Is(err, ErrOutOfDomain))
+	assert.True(t, errors.Is(err, ErrDivisionByZero))
+	assert.False(t, errors.Is(err, ErrTypeMismatch))
+
+	_, err = tql.Exec(map[string]Node{"dev.name": StringNode("pump"), "dev.x": IntNode(4)})
+	assert.NoError(t, err)
+}
+
+func TestContextFuncE(t *testing.T) {
+	funcs := NewFunctionRegistry()
+	assert.NoError(t, funcs.Register(Function{
+		Name:   "lookup",
+		Params: []Param{{"key", stringTypes}},
+		Result: String,
+		CallE: func(ctx Context, args ...Node) (Node, error) {
+			key, _ := stringArg(args[0])
+			// the other values of the input are readable from the context.
+			if site := ctx.Value("dev.site"); site.Type() != Undefined {
+				key = site.String() + "/" + key
+			}
+			if key == "s1/pump" {
+				return StringNode("P-100"), nil
+			}
+			return nil, fmt.Errorf("%w: %s", errNotFound, key)
+		},
+	}))
+
+	tql, err := NewTDTL(`insert into target select lookup(dev.name) as asset, upper(lookup(dev.kind)) as kind`,
+		nil, WithFunctions(funcs))
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{
+		"dev.site": StringNode("s1"),
+		"dev.name": StringNode("pump"),
+		"dev.kind": StringNode("valve"),
+	})
+	assert.Equal(t, "P-100", ret["asset"].String())
+	assert.Equal(t, Undefined, ret["kind"].Type())
+	assert.EqualError(t, err, "kind: [1:59]lookup(dev.kind): not found: s1/valve")
+	assert.True(t, errors.Is(err, errNotFound))
+
+	e, err := NewExpr(`concat('#', lookup('x'))`, nil, WithFunctions(funcs))
+	assert.NoError(t, err)
+	ret1 := e.Eval(nil)
+	var evalErr *EvalError
+	assert.True(t, errors.As(ret1.Error(), &evalErr))
+	assert.Equal(t, `lookup("x")`, evalErr.Expr)
+}
@@ -17,6 +17,7 @@ package tdtl
 
 import (
 	"math"
+	"math/big"
 	"strings"
 
 	"github.com/tkeel-io/tdtl/parser"
@@ -82,6 +83,8 @@ func evalRuleQL(ctx Context, expr Expr) Node {
 		return evalCallExpr(ctx, expr)
 	case *JSONPathExpr:
 		return evalJSONExpr(ctx, expr)
+	case *InExpr:
+		return evalInExpr(ctx, expr)
 	case BoolNode:
 		return expr
 	case IntNode:
@@ -90,6 +93,12 @@ func evalRuleQL(ctx Context, expr Expr) Node {
 		return expr
 	case StringNode:
 		return expr
+	case DecimalNode:
+		return expr
+	case TimestampNode:
+		return expr
+	case DurationNode:
+		return expr
 	case JSONNode:
 		return expr
 	}
@@ -135,6 +144,8 @@ func eval(ctx Context, expr Expr) Node {
 		return evalJSONExpr(ctx, expr)
 	case *SwitchExpr:
 		return evalSwitchExpr(ctx, expr)
+	case *InExpr:
+		return evalInExpr(ctx, expr)
 	case BoolNode:
 		return expr
 	case IntNode:
@@ -143,6 +154,12 @@ func eval(ctx Context, expr Expr) Node {
 		return expr
 	case StringNode:
 		return expr
+	case DecimalNode:
+		return expr
+	case TimestampNode:
+		return expr
+	case DurationNode:
+		return expr
 	case JSONNode:
 		return expr
 	case *CallExpr:
@@ -166,27 +183,56 @@ func evalSelect(ctx Context, expr Expr) Node {
 
 func evalFieldListExpr(ctx Context, list FieldsExpr) Node {
 	v := New("{}")
-	for _, expr := range list {
-		ret := eval(ctx, expr.exp)
-		if expr.alias != "" {
-			v.Set(expr.alias, ret)
+	nodes, errs := evalFields(ctx, list)
+	for i, expr := range list {
+		// undefined has no json, setting it would break the object.
+		if expr.alias != "" && nodes[i].Type() != Undefined {
+			v.Set(expr.alias, nodes[i])
 			if v.Error() != nil {
 				//fmt.Println("error in %v", v.Error())
 				continue
 			}
 		}
 	}
+	if len(errs) > 0 {
+		// the errors of the fields, the collect itself is still usable.
+		v.err = errs
+	}
 	return v
 }
 
+// evalFields evaluates the fields of the list in order, with their own types.
+func evalFields(ctx Context, list FieldsExpr) ([]Node, FieldErrors) {
+	nodes := make([]Node, len(list))
+	var errs FieldErrors
+	for i, expr := range list {
+		nodes[i] = eval(ctx, expr.exp)
+		if nodes[i].Error() != nil {
+			errs = append(errs, fieldError(expr.alias, expr.exp, nodes[i].Error()))
+		}
+	}
+	return nodes, errs
+}
+
 func evalBinaryExpr(ctx Context, expr *BinaryExpr) Node {
 	lhs := eval(ctx, expr.LHS)
 	rhs := eval(ctx, expr.RHS)
+	for _, operand := range []Node{lhs, rhs} {
+		if operand.Error() != nil {
+			return operand
+		}
+	}
+	o := optionsOf(ctx)
+	if o.mode == StrictMode {
+		if err := checkStrict(expr.Op, lhs, rhs); err != nil {
+			return exprError(expr, errorNode(err))
+		}
+	}
 	if ret := evalBinaryOverload(expr.Op, lhs, rhs); ret != nil {
-		return ret
+		return exprError(expr, ret)
 	}
 
-	return evalBinary(expr.Op, lhs, rhs)
+	return exprError(expr, evalBinary(o, expr.Op, lhs, rhs))
 }
 
 // evalBinary eval simple types.
@@ -197,9 +243,7 @@ func evalBinaryOverload(op int, lhs, rhs Node) Node {
 		switch lhs := lhs.(type) {
 		case StringNode:
 			switch rhs := rhs.(type) {
-			case IntNode:
-				return evalBinaryOverload(op, lhs, rhs.To(String))
-			case FloatNode:
+			case IntNode, FloatNode, DecimalNode:
 				return evalBinaryOverload(op, lhs, rhs.To(String))
 			case StringNode:
 				return lhs + rhs
@@ -208,9 +252,7 @@ func evalBinaryOverload(op int, lhs, rhs Node) Node {
 		switch rhs := rhs.(type) {
 		case StringNode:
 			switch lhs := lhs.(type) {
-			case IntNode:
-				return evalBinaryOverload(op, lhs.To(String), rhs)
-			case FloatNode:
+			case IntNode, FloatNode, DecimalNode:
 				return evalBinaryOverload(op, lhs.To(String), rhs)
 			case StringNode:
 				return lhs + rhs
@@ -221,16 +263,42 @@ func evalBinaryOverload(op int, lhs, rhs Node) Node {
 }
 
 // evalBinary eval simple types.
-func evalBinary(op int, lhs, rhs Node) Node {
+func evalBinary(o *options, op int, lhs, rhs Node) Node {
+	if isStructural(lhs) || isStructural(rhs) {
+		if isArithmeticOP(op) {
+			return evalBinaryBroadcast(o, op, lhs, rhs)
+		}
+		return evalBinaryStructural(op, lhs, rhs)
+	}
+	switch lhs.(type) {
+	case TimestampNode, DurationNode:
+		return evalBinaryTime(op, lhs, rhs)
+	}
+	switch rhs.(type) {
+	case TimestampNode, DurationNode:
+		return evalBinaryTime(op, lhs, rhs)
+	}
+	if lhs, ok := lhs.(BytesNode); ok {
+		return evalBinaryBytes(op, lhs, rhs)
+	}
+	if rhs, ok := rhs.(BytesNode); ok {
+		if lhs, ok := lhs.(StringNode); ok {
+			return evalBinaryBytes(op, lhs.To(Bytes).(BytesNode), rhs)
+		}
+		return UNDEFINED_RESULT
+	}
+
 	switch lhs := lhs.(type) {
 	case StringNode:
 		switch rhs := rhs.(type) {
 		case FloatNode, IntNode:
-			return evalBinary(op, lhs.To(Number), rhs)
+			return evalBinary(o, op, lhs.To(Number), rhs)
+		case DecimalNode:
+			return evalBinary(o, op, lhs.To(Decimal), rhs)
 		case BoolNode:
-			return evalBinary(op, lhs.To(Bool), rhs)
+			return evalBinary(o, op, lhs.To(Bool), rhs)
 		case StringNode:
-			return evalBinaryString(op, lhs, rhs)
+			return evalBinaryString(o, op, lhs, rhs)
 		}
 		return UNDEFINED_RESULT
 	case FloatNode:
@@ -239,6 +307,10 @@ func evalBinary(op int, lhs, rhs Node) Node {
 			return evalBinaryFloat(op, lhs, rhs)
 		case IntNode:
 			return evalBinaryFloat(op, lhs, FloatNode(rhs))
+		case DecimalNode:
+			if lhs, ok := toDecimal(lhs); ok {
+				return evalBinaryDecimal(o, op, lhs, rhs)
+			}
 		case StringNode:
 			switch rhs := rhs.To(Float).(type) {
 			case FloatNode:
@@ -252,6 +324,8 @@ func evalBinary(op int, lhs, rhs Node) Node {
 			return evalBinaryInt(op, lhs, rhs)
 		case FloatNode:
 			return evalBinaryFloat(op, FloatNode(lhs), rhs)
+		case DecimalNode:
+			return evalBinaryDecimal(o, op, NewDecimalFromInt(int64(lhs)), rhs)
 		case StringNode:
 			switch rhs := rhs.To(Number).(type) {
 			case FloatNode:
@@ -261,6 +335,12 @@ func evalBinary(op int, lhs, rhs Node) Node {
 			}
 		}
 		return UNDEFINED_RESULT
+	case DecimalNode:
+		// decimal is opt-in, once an operand is a decimal the other one is promoted.
+		if rhs, ok := toDecimal(rhs); ok {
+			return evalBinaryDecimal(o, op, lhs, rhs)
+		}
+		return UNDEFINED_RESULT
 	case BoolNode:
 		switch rhs := rhs.(type) {
 		case BoolNode:
@@ -272,7 +352,7 @@ func evalBinary(op int, lhs, rhs Node) Node {
 			}
 		case *JSONNode:
 			if isBooleanOP(op) {
-				return evalBinary(op, BoolNode(false), rhs)
+				return evalBinary(o, op, BoolNode(false), rhs)
 			}
 		}
 		return UNDEFINED_RESULT
@@ -281,7 +361,7 @@ func evalBinary(op int, lhs, rhs Node) Node {
 			return BoolNode(false)
 		}
 		if isLogicOP(op) {
-			return evalBinary(op, BoolNode(false), rhs)
+			return evalBinary(o, op, BoolNode(false), rhs)
 		}
 	default:
 		return UNDEFINED_RESULT
@@ -289,9 +369,9 @@ func evalBinary(op int, lhs, rhs Node) Node {
 	return UNDEFINED_RESULT
 }
 
-func evalBinaryString(op int, lhs, rhs StringNode) Node {
+func evalBinaryString(o *options, op int, lhs, rhs StringNode) Node {
 	if !isBooleanOP(op) {
-		return evalBinary(op, lhs.To(Number), rhs.To(Number))
+		return evalBinary(o, op, lhs.To(Number), rhs.To(Number))
 	}
 	// The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
 	ret := strings.Compare(string(lhs), string(rhs))
@@ -312,20 +392,39 @@ func evalBinaryString(op int, lhs, rhs StringNode) Node {
 	return UNDEFINED_RESULT
 }
 
+// evalBinaryInt eval int operands, the arithmetic is overflow-checked and
+// an overflowed result is promoted to a decimal.
 func evalBinaryInt(op int, lhs, rhs IntNode) Node {
 	switch op {
 	case parser.TDTLParserADD:
-		return lhs + rhs
+		if ret, ok := addInt64(int64(lhs), int64(rhs)); ok {
+			return IntNode(ret)
+		}
+		return NewDecimalFromInt(int64(lhs)).Add(NewDecimalFromInt(int64(rhs)))
 	case parser.TDTLParserSUB:
-		return lhs - rhs
+		if ret, ok := subInt64(int64(lhs), int64(rhs)); ok {
+			return IntNode(ret)
+		}
+		return NewDecimalFromInt(int64(lhs)).Sub(NewDecimalFromInt(int64(rhs)))
 	case parser.TDTLParserMUL:
-		return lhs * rhs
+		if ret, ok := mulInt64(int64(lhs), int64(rhs)); ok {
+			return IntNode(ret)
+		}
+		// the product of integers has no scale and can not be out of range.
+		ret, _ := NewDecimalFromInt(int64(lhs)).Mul(NewDecimalFromInt(int64(rhs)))
+		return ret
 	case parser.TDTLParserDIV:
 		if rhs == 0 {
-			return UNDEFINED_RESULT
+			return errorNode(ErrDivisionByZero)
+		}
+		if lhs == math.MinInt64 && rhs == -1 {
+			return NewDecimalFromInt(int64(lhs)).Neg()
 		}
 		return lhs / rhs
 	case parser.TDTLParserMOD:
+		if rhs == 0 {
+			return errorNode(ErrDivisionByZero)
+		}
 		return lhs % rhs
 	case parser.TDTLLexerEQ:
 		return BoolNode(lhs == rhs)
@@ -343,6 +442,27 @@ func evalBinaryInt(op int, lhs, rhs IntNode) Node {
 	return UNDEFINED_RESULT
 }
 
+func addInt64(a, b int64) (int64, bool) {
+	c := a + b
+	return c, (c > a) == (b > 0)
+}
+
+func subInt64(a, b int64) (int64, bool) {
+	c := a - b
+	return c, (c < a) == (b > 0)
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || c/b != a {
+		return 0, false
+	}
+	return c, true
+}
+
 func evalBinaryFloat(op int, lhs, rhs FloatNode) Node {
 	switch op {
 	case parser.TDTLParserADD:
@@ -353,10 +473,13 @@ func evalBinaryFloat(op int, lhs, rhs FloatNode) Node {
 		return lhs * rhs
 	case parser.TDTLParserDIV:
 		if rhs == 0 {
-			return UNDEFINED_RESULT
+			return errorNode(ErrDivisionByZero)
 		}
 		return lhs / rhs
 	case parser.TDTLParserMOD:
+		if rhs == 0 {
+			return errorNode(ErrDivisionByZero)
+		}
 		return FloatNode(math.Mod(float64(lhs), float64(rhs)))
 	case parser.TDTLLexerEQ:
 		return BoolNode(lhs == rhs)
@@ -374,6 +497,48 @@ func evalBinaryFloat(op int, lhs, rhs FloatNode) Node {
 	return UNDEFINED_RESULT
 }
 
+func evalBinaryDecimal(o *options, op int, lhs, rhs DecimalNode) Node {
+	switch op {
+	case parser.TDTLParserADD:
+		return lhs.Add(rhs)
+	case parser.TDTLParserSUB:
+		return lhs.Sub(rhs)
+	case parser.TDTLParserMUL:
+		ret, err := lhs.Mul(rhs)
+		if err != nil {
+			return errorNode(err)
+		}
+		return ret
+	case parser.TDTLParserDIV:
+		scale := maxScale(o.divisionScale, maxScale(lhs.scale, rhs.scale))
+		ret, err := lhs.Div(rhs, scale, o.rounding)
+		if err != nil {
+			return errorNode(err)
+		}
+		return ret
+	case parser.TDTLParserMOD:
+		if rhs.Sign() == 0 {
+			return errorNode(ErrDivisionByZero)
+		}
+		scale := maxScale(lhs.scale, rhs.scale)
+		rem := new(big.Int).Rem(lhs.rescale(scale), rhs.rescale(scale))
+		return DecimalNode{unscaled: rem, scale: scale}
+	case parser.TDTLLexerEQ:
+		return BoolNode(lhs.Cmp(rhs) == 0)
+	case parser.TDTLLexerNE:
+		return BoolNode(lhs.Cmp(rhs) != 0)
+	case parser.TDTLLexerLT:
+		return BoolNode(lhs.Cmp(rhs) < 0)
+	case parser.TDTLLexerLTE:
+		return BoolNode(lhs.Cmp(rhs) <= 0)
+	case parser.TDTLLexerGT:
+		return BoolNode(lhs.Cmp(rhs) > 0)
+	case parser.TDTLLexerGTE:
+		return BoolNode(lhs.Cmp(rhs) >= 0)
+	}
+	return UNDEFINED_RESULT
+}
+
 func evalBinaryBool(op int, lhs, rhs BoolNode) Node {
 	switch op {
 	case parser.TDTLParserAND:
@@ -390,20 +555,67 @@ func evalBinaryBool(op int, lhs, rhs BoolNode) Node {
 	return UNDEFINED_RESULT
 }
 
+func evalInExpr(ctx Context, expr *InExpr) Node {
+	values := make([]Node, 0, len(expr.list)+1)
+	for _, x := range append([]Expr{expr.exp}, expr.list...) {
+		value := eval(ctx, x)
+		if value.Error() != nil {
+			return value
+		}
+		values = append(values, value)
+	}
+	ret := inFunc(values...)
+	if b, ok := ret.(BoolNode); ok && expr.not {
+		return !b
+	}
+	return ret
+}
+
 func evalCallExpr(ctx Context, expr *CallExpr) Node {
-	n := len(expr.args)
-	if n == 0 {
-		return ctx.Call(expr, []Node{})
+	values := make([]Node, 0, len(expr.args))
+	for _, arg := range expr.args {
+		value := eval(ctx, arg)
+		if value.Error() != nil {
+			// the call is not made on a failed argument.
+			return value
+		}
+		values = append(values, value)
 	}
-	values := make([]Node, 0, n)
-	for _, expr := range expr.args {
-		values = append(values, eval(ctx, expr))
+	// the functions of the caller come first, they may override a built-in name.
+	if ret := callContext(ctx, expr, values); ret.Type() != Undefined || ret.Error() != nil {
+		return exprError(expr, ret)
 	}
-	ret := ctx.Call(expr, values)
-	if ret.Type() != Undefined {
-		return ret
+	o := optionsOf(ctx)
+	if fn := o.lookupFunction(expr.key); fn != nil {
+		if o.mode == StrictMode {
+			if err := checkStrictArgs(fn, values); err != nil {
+				return exprError(expr, errorNode(err))
+			}
+		}
+		return exprError(expr, fn.call(ctx, o, expr, values))
 	}
-	return EvalCallExpr(ctx, expr)
+	return exprError(expr, EvalCallExpr(ctx, expr))
+}
+
+// callContext calls the function expr of the caller's context ctx, the built-in
+// functions of DefaultValue are left to lookupFunction.
+func callContext(ctx Context, expr *CallExpr, args []Node) Node {
+	switch c := ctx.(type) {
+	case MutilContext:
+		for _, x := range c {
+			if ret := callContext(x, expr, args); ret.Type() != Undefined || ret.Error() != nil {
+				return ret
+			}
+		}
+		return UNDEFINED_RESULT
+	case modeContext:
+		return callContext(c.Context, expr, args)
+	case *udfContext:
+		return callContext(c.Context, expr, args)
+	case *value:
+		return UNDEFINED_RESULT
+	}
+	return ctx.Call(expr, args)
 }
 
 var EvalCallExpr = func(ctx Context, expr *CallExpr) Node {
@@ -412,8 +624,11 @@ var EvalCallExpr = func(ctx Context, expr *CallExpr) Node {
 
 func evalSwitchExpr(ctx Context, expr *SwitchExpr) Node {
 	value := eval(ctx, expr.exp)
+	if value.Error() != nil {
+		return value
+	}
 	for _, e := range expr.list {
-		if value == eval(ctx, e.when) {
+		if Equal(value, eval(ctx, e.when)) {
 			return eval(ctx, e.then)
 		}
 	}
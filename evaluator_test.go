@@ -1,11 +1,14 @@
 package tdtl
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tkeel-io/tdtl/parser"
 )
 
 func TestEval(t *testing.T) {
@@ -84,6 +87,11 @@ func TestDefaultValue_Eval(t *testing.T) {
 		{"json", JSONRaw.JSON, "friends[0]", New("{\"first\": \"Dale\", \"last\": \"Murphy\", \"age\": 44}")},
 		{"json", JSONRaw.JSON, "friends[#]", IntNode(3)},
 		{"json", JSONRaw.JSON, "friends[#].first", New("[\"Dale\",\"Roger\",\"Jane\"]")},
+		{"typeof", JSONRaw.JSON, "typeof(age)", StringNode("Int")},
+		{"typeof", JSONRaw.JSON, "typeof(name)", StringNode("Object")},
+		{"typeof", JSONRaw.JSON, "typeof(children)", StringNode("Array")},
+		{"typeof", JSONRaw.JSON, "typeof(name.first)", StringNode("String")},
+		{"typeof", JSONRaw.JSON, "typeof(unknown)", StringNode("Undefined")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,6 +107,46 @@ func TestDefaultValue_Eval(t *testing.T) {
 	}
 }
 
+func TestCheckedInteger(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    string
+		wantErr error
+	}{
+		{`9223372036854775807 + 1`, "9223372036854775808", nil},
+		{`-9223372036854775807 - 2`, "-9223372036854775809", nil},
+		{`9223372036854775807 * 2`, "18446744073709551614", nil},
+		{`4611686018427387904 * -2`, "-9223372036854775808", nil},
+		{`9223372036854775806 + 1`, "9223372036854775807", nil},
+		{`1 / 0`, "", ErrDivisionByZero},
+		{`1 % 0`, "", ErrDivisionByZero},
+		{`1.5 % 0`, "", ErrDivisionByZero},
+		{`1.5 / 0`, "", ErrDivisionByZero},
+		{`decimal('1') % 0`, "", ErrDivisionByZero},
+		{`'10' % '0'`, "", ErrDivisionByZero},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		if err != nil {
+			t.Fatalf("parse %s: %v", tt.expr, err)
+		}
+		got := eval(DefaultValue, expr)
+		if got.String() != tt.want {
+			t.Errorf("%s = %v, want %v", tt.expr, got, tt.want)
+		}
+		if !errors.Is(got.Error(), tt.wantErr) {
+			t.Errorf("%s error = %v, want %v", tt.expr, got.Error(), tt.wantErr)
+		}
+	}
+
+	if _, ok := eval(DefaultValue, &BinaryExpr{Op: parser.TDTLParserMUL, LHS: IntNode(math.MaxInt64), RHS: IntNode(2)}).(DecimalNode); !ok {
+		t.Errorf("overflowed result should be promoted to decimal")
+	}
+	if !errors.Is(FloatNode(1e19).To(Int).Error(), ErrIntegerOverflow) {
+		t.Errorf("float to int should report overflow")
+	}
+}
+
 func TestBoolExpr(t *testing.T) {
 	tests := []struct {
 		name string
@@ -11,21 +11,32 @@ type expr struct {
 	sources  map[string][]string
 	extFunc  map[string]ContextFunc
 	listener *TDTLListener
+	options  *options
 }
 
-func NewExpr(sql string, extFunc map[string]ContextFunc) (Expression, error) {
+func NewExpr(sql string, extFunc map[string]ContextFunc, opts ...Option) (Expression, error) {
 	parse, listener := parse(sql)
-	antlr.ParseTreeWalkerDefault.Walk(listener, parse.Field_elem())
+	antlr.ParseTreeWalkerDefault.Walk(listener, parse.Expression())
 	err := listener.error()
 	if err != nil {
 		return nil, err
 	}
-
-	return &expr{
+	e := &expr{
 		listener: listener,
 		sources:  listener.sources,
 		extFunc:  extFunc,
-	}, nil
+		options:  newOptions(opts),
+	}
+	if err := e.options.importPackages(listener.imports); err != nil {
+		return nil, err
+	}
+	if err := e.options.createFunctions(listener.creates, extFunc); err != nil {
+		return nil, err
+	}
+	if err := checkCalls(e.expr(), extFunc, e.options); err != nil {
+		return nil, err
+	}
+	return e, nil
 }
 
 func (e *expr) expr() Expr {
@@ -33,8 +44,8 @@ func (e *expr) expr() Expr {
 }
 
 func (e *expr) Eval(in map[string]Node) Node {
-	ctx := NewMapContext(in, e.extFunc)
-	return EvalRuleQL(ctx, e.expr())
+	ctx := modeContext{NewMapContext(in, e.extFunc), e.options}
+	return e.options.applyPrecision("", EvalRuleQL(ctx, e.expr()))
 }
 
 func (e *expr) Sources() map[string][]string {
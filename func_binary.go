@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// payloadArg returns the bytes of a frame argument, a string is read as bytes()
+// reads it: by its hint, "base64:" or "hex:", or else as the bytes of its text.
+func payloadArg(node Node) ([]byte, Node) {
+	switch node := node.(type) {
+	case BytesNode:
+		return node, nil
+	}
+	if node.Type() == Undefined {
+		return nil, node
+	}
+	s, ok := stringArg(node)
+	if !ok {
+		return nil, errorNode(fmt.Errorf("%w: %s", ErrInvalidArgument, node.Type()))
+	}
+	b, ok := stringBytes(s)
+	if !ok {
+		return nil, errorNode(fmt.Errorf("%w: frame %q", ErrInvalidEncoding, s))
+	}
+	return b, nil
+}
+
+// offsetArg returns the offset of a field of size bytes in a frame of n bytes,
+// a negative offset counts from the end.
+func offsetArg(node Node, size, n int) (int, Node) {
+	offset, ok := intArg(node)
+	if !ok {
+		return 0, UNDEFINED_RESULT
+	}
+	if offset < 0 {
+		offset += n
+	}
+	if offset < 0 || offset > n || size > n-offset {
+		return 0, errorNode(fmt.Errorf("%w: %d bytes at %d of %d", ErrOutOfRange, size, offset, n))
+	}
+	return offset, nil
+}
+
+// binaryField decodes a field of fixed size.
+type binaryField struct {
+	size   int
+	decode func(b []byte) Node
+}
+
+func float32Node(bits uint32) Node {
+	return FromGo(math.Float32frombits(bits))
+}
+
+// binaryFields are the field types of unpack, named by sign, bits and byte order.
+var binaryFields = map[string]binaryField{
+	"u8":    {1, func(b []byte) Node { return IntNode(b[0]) }},
+	"i8":    {1, func(b []byte) Node { return IntNode(int8(b[0])) }},
+	"u16be": {2, func(b []byte) Node { return IntNode(binary.BigEndian.Uint16(b)) }},
+	"u16le": {2, func(b []byte) Node { return IntNode(binary.LittleEndian.Uint16(b)) }},
+	"i16be": {2, func(b []byte) Node { return IntNode(int16(binary.BigEndian.Uint16(b))) }},
+	"i16le": {2, func(b []byte) Node { return IntNode(int16(binary.LittleEndian.Uint16(b))) }},
+	"u32be": {4, func(b []byte) Node { return IntNode(binary.BigEndian.Uint32(b)) }},
+	"u32le": {4, func(b []byte) Node { return IntNode(binary.LittleEndian.Uint32(b)) }},
+	"i32be": {4, func(b []byte) Node { return IntNode(int32(binary.BigEndian.Uint32(b))) }},
+	"i32le": {4, func(b []byte) Node { return IntNode(int32(binary.LittleEndian.Uint32(b))) }},
+	"u64be": {8, func(b []byte) Node { return FromGo(binary.BigEndian.Uint64(b)) }},
+	"u64le": {8, func(b []byte) Node { return FromGo(binary.LittleEndian.Uint64(b)) }},
+	"i64be": {8, func(b []byte) Node { return IntNode(binary.BigEndian.Uint64(b)) }},
+	"i64le": {8, func(b []byte) Node { return IntNode(binary.LittleEndian.Uint64(b)) }},
+	"f32be": {4, func(b []byte) Node { return float32Node(binary.BigEndian.Uint32(b)) }},
+	"f32le": {4, func(b []byte) Node { return float32Node(binary.LittleEndian.Uint32(b)) }},
+	"f64be": {8, func(b []byte) Node { return FloatNode(math.Float64frombits(binary.BigEndian.Uint64(b))) }},
+	"f64le": {8, func(b []byte) Node { return FloatNode(math.Float64frombits(binary.LittleEndian.Uint64(b))) }},
+}
+
+// fieldFunc adapts a field type to f(payload[, offset]), the offset is 0 by default.
+func fieldFunc(typ string) ContextFunc {
+	field := binaryFields[typ]
+	return func(args ...Node) Node {
+		if len(args) != 1 && len(args) != 2 {
+			return UNDEFINED_RESULT
+		}
+		b, bad := payloadArg(args[0])
+		if bad != nil {
+			return bad
+		}
+		offset := 0
+		if len(args) == 2 {
+			if offset, bad = offsetArg(args[1], field.size, len(b)); bad != nil {
+				return bad
+			}
+		} else if len(b) < field.size {
+			return errorNode(fmt.Errorf("%w: %d bytes at 0 of %d", ErrOutOfRange, field.size, len(b)))
+		}
+		return field.decode(b[offset : offset+field.size])
+	}
+}
+
+var (
+	uint16BEFunc  = fieldFunc("u16be")
+	uint16LEFunc  = fieldFunc("u16le")
+	int32BEFunc   = fieldFunc("i32be")
+	int32LEFunc   = fieldFunc("i32le")
+	float32BEFunc = fieldFunc("f32be")
+	float32LEFunc = fieldFunc("f32le")
+)
+
+// bytesAtFunc bytes_at(payload, offset[, length]) returns the byte at offset as
+// an Int, or length bytes from offset as bytes.
+var bytesAtFunc = func(args ...Node) Node {
+	if len(args) != 2 && len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	b, bad := payloadArg(args[0])
+	if bad != nil {
+		return bad
+	}
+	if len(args) == 2 {
+		offset, bad := offsetArg(args[1], 1, len(b))
+		if bad != nil {
+			return bad
+		}
+		return IntNode(b[offset])
+	}
+	size, ok := intArg(args[2])
+	if !ok || size < 0 {
+		return UNDEFINED_RESULT
+	}
+	offset, bad := offsetArg(args[1], size, len(b))
+	if bad != nil {
+		return bad
+	}
+	return BytesNode(append([]byte(nil), b[offset:offset+size]...))
+}
+
+// bitFunc bit(x, n) reports whether bit n of the integer x is set, bit 0 is the least significant.
+var bitFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	x, ok1 := args[0].To(Int).(IntNode)
+	n, ok2 := intArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	if n < 0 || n > 63 {
+		return errorNode(fmt.Errorf("%w: bit %d", ErrOutOfRange, n))
+	}
+	return BoolNode(uint64(x)>>uint(n)&1 == 1)
+}
+
+// bitsFunc bits(x, from, len) returns the unsigned value of len bits of the integer x from bit from.
+var bitsFunc = func(args ...Node) Node {
+	if len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	x, ok1 := args[0].To(Int).(IntNode)
+	from, ok2 := intArg(args[1])
+	n, ok3 := intArg(args[2])
+	if !ok1 || !ok2 || !ok3 {
+		return UNDEFINED_RESULT
+	}
+	if from < 0 || n < 1 || from > 64 || n > 64-from {
+		return errorNode(fmt.Errorf("%w: %d bits from bit %d", ErrOutOfRange, n, from))
+	}
+	return IntNode(uint64(x) >> uint(from) & (1<<uint(n) - 1))
+}
+
+// unpackFunc unpack(payload, layout) decodes the fields of a frame into an object,
+// layout lists the fields in order as 'type:name', e.g. 'u16be:voltage,i16le:temp'.
+// The types are u8, i8, u16, i16, u32, i32, u64, i64, f32 and f64 followed by
+// be or le for the byte order, 'skip:n' skips n bytes.
+var unpackFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	b, bad := payloadArg(args[0])
+	if bad != nil {
+		return bad
+	}
+	layout, ok := stringArg(args[1])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+
+	ret := New("{}")
+	offset := 0
+	for _, item := range strings.Split(layout, ",") {
+		typ, name, err := parseUnpackItem(item)
+		if err != nil {
+			return errorNode(err)
+		}
+		if typ == "skip" {
+			n, err := strconv.Atoi(name)
+			if err != nil || n < 0 {
+				return errorNode(fmt.Errorf("%w: unpack %q", ErrInvalidArgument, item))
+			}
+			if n > len(b)-offset {
+				return errorNode(fmt.Errorf("%w: skip %d at %d of %d bytes", ErrOutOfRange, n, offset, len(b)))
+			}
+			offset += n
+			continue
+		}
+		field := binaryFields[typ]
+		if field.size > len(b)-offset {
+			return errorNode(fmt.Errorf("%w: %s at %d of %d bytes", ErrOutOfRange, name, offset, len(b)))
+		}
+		node := field.decode(b[offset : offset+field.size])
+		if f, ok := node.(FloatNode); ok && (math.IsNaN(float64(f)) || math.IsInf(float64(f), 0)) {
+			// json has no NaN.
+			node = NULL_RESULT
+		}
+		ret.Set(name, node)
+		if ret.err != nil {
+			return errorNode(ret.err)
+		}
+		offset += field.size
+	}
+	return ret.Node()
+}
+
+func parseUnpackItem(item string) (typ, name string, err error) {
+	parts := strings.SplitN(strings.TrimSpace(item), ":", 2)
+	if len(parts) == 2 {
+		typ, name = strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1])
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("%w: unpack %q", ErrInvalidArgument, item)
+	}
+	if _, ok := binaryFields[typ]; !ok && typ != "skip" {
+		return "", "", fmt.Errorf("%w: unpack type %q", ErrInvalidArgument, typ)
+	}
+	return typ, name, nil
+}
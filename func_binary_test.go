@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"frame":  StringNode("hex:00e69cff41ac0000"),
+		"b64":    StringNode("base64:AOac/0GsAAA="),
+		"raw":    BytesNode{0x00, 0x00, 0xac, 0x41},
+		"status": IntNode(0x2c),
+	}, nil)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`bytes_at(frame, 1)`, "230"},
+		{`bytes_at(frame, -1)`, "0"},
+		{`bytes_at(b64, 2, 2)`, "base64:nP8="},
+		{`uint16_be(frame)`, "230"},
+		{`uint16_le(frame, 2)`, "65436"},
+		{`int32_be(frame, 4)`, "1101791232"},
+		{`int32_le('hex:feffffff')`, "-2"},
+		{`float32_be(frame, 4)`, "21.5"},
+		{`float32_le(raw)`, "21.5"},
+		{`bit(status, 2)`, "true"},
+		{`bit(status, 4)`, "false"},
+		{`bits(status, 2, 4)`, "11"},
+		{`bits(-1, 60, 4)`, "15"},
+		{`unpack(frame, 'u16be:voltage, i16le:temp, f32be:power')`, `{"voltage":230,"temp":-100,"power":21.5}`},
+		{`unpack(b64, 'skip:2,u8:hi,i8:lo')`, `{"hi":156,"lo":-1}`},
+		{`json_get(unpack(frame, 'u16be:v'), 'v') * 0.1`, "23"},
+		{`uint16_be(missing)`, ""},
+		// a string without hint is the bytes of its text, whether it reaches unpack directly or by bytes().
+		{`unpack('01020304', 'u16be:v')`, `{"v":12337}`},
+		{`unpack(bytes('01020304'), 'u16be:v')`, `{"v":12337}`},
+		{`unpack(hex_decode('01020304'), 'u16be:v')`, `{"v":258}`},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+
+	errs := []struct {
+		expr string
+		err  error
+	}{
+		{`uint16_be(frame, 7)`, ErrOutOfRange},
+		{`bytes_at(raw, 4)`, ErrOutOfRange},
+		{`unpack(raw, 'u32be:a,u8:b')`, ErrOutOfRange},
+		{`unpack(raw, 'u24:a')`, ErrInvalidArgument},
+		{`unpack(raw, 'u8')`, ErrInvalidArgument},
+		{`int32_be('hex:not a frame')`, ErrInvalidEncoding},
+		{`int32_be('base64:***')`, ErrInvalidEncoding},
+		{`bytes_at('hex:000102', 1, 9223372036854775807)`, ErrOutOfRange},
+		{`bytes_at('hex:000102', 9223372036854775807)`, ErrOutOfRange},
+		{`uint16_be('hex:000102', 9223372036854775807)`, ErrOutOfRange},
+		{`unpack('hex:000102', 'skip:9223372036854775807,u8:x')`, ErrOutOfRange},
+		{`bits(-1, 61, 4)`, ErrOutOfRange},
+		{`bits(-1, 9223372036854775807, 9223372036854775807)`, ErrOutOfRange},
+	}
+	for _, tt := range errs {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), tt.err), tt.expr)
+	}
+}
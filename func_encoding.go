@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"net/url"
+	"strings"
+)
+
+// dataArg returns the bytes of a data argument, the text of a string or the
+// json of an object or array. An undefined argument is passed through by the callers.
+func dataArg(node Node) ([]byte, error) {
+	if b, ok := node.(BytesNode); ok {
+		return b, nil
+	}
+	if s, ok := stringArg(node); ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, node.Type())
+}
+
+// encodeFunc adapts an encoding of data to a string.
+func encodeFunc(encode func([]byte) string) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		if args[0].Type() == Undefined {
+			return args[0]
+		}
+		data, err := dataArg(args[0])
+		if err != nil {
+			return errorNode(err)
+		}
+		return StringNode(encode(data))
+	}
+}
+
+// decodeFunc adapts a decoding of a string, it reports ErrInvalidEncoding on malformed text.
+func decodeFunc(name string, decode func(string) ([]byte, error)) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		if args[0].Type() == Undefined {
+			return args[0]
+		}
+		s, ok := stringArg(args[0])
+		if !ok {
+			return errorNode(fmt.Errorf("%w: %s", ErrInvalidArgument, args[0].Type()))
+		}
+		b, err := decode(s)
+		if err != nil {
+			return errorNode(fmt.Errorf("%w: %s: %v", ErrInvalidEncoding, name, err))
+		}
+		return BytesNode(b)
+	}
+}
+
+// hashFunc adapts a hash to the hex digest of data.
+func hashFunc(h func() hash.Hash) ContextFunc {
+	return encodeFunc(func(data []byte) string {
+		d := h()
+		d.Write(data)
+		return hex.EncodeToString(d.Sum(nil))
+	})
+}
+
+var (
+	// base64Func base64(x) encodes x in standard base64.
+	base64Func = encodeFunc(base64.StdEncoding.EncodeToString)
+	// base64urlFunc base64url(x) encodes x in unpadded url-safe base64, as in JWT.
+	base64urlFunc = encodeFunc(base64.RawURLEncoding.EncodeToString)
+	// base64DecodeFunc base64_decode(s) decodes standard or url-safe base64, padded or not.
+	base64DecodeFunc = decodeFunc("base64", decodeBase64)
+	hexEncodeFunc    = encodeFunc(hex.EncodeToString)
+	hexDecodeFunc    = decodeFunc("hex", hex.DecodeString)
+	urlEncodeFunc    = encodeFunc(func(data []byte) string { return url.QueryEscape(string(data)) })
+	md5Func          = hashFunc(md5.New)
+	sha1Func         = hashFunc(sha1.New)
+	sha256Func       = hashFunc(sha256.New)
+)
+
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// urlDecodeFunc url_decode(s) decodes url query escaping, the result is a string.
+var urlDecodeFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined {
+		return args[0]
+	}
+	s, ok := stringArg(args[0])
+	if !ok {
+		return errorNode(fmt.Errorf("%w: %s", ErrInvalidArgument, args[0].Type()))
+	}
+	ret, err := url.QueryUnescape(s)
+	if err != nil {
+		return errorNode(fmt.Errorf("%w: url: %v", ErrInvalidEncoding, err))
+	}
+	return StringNode(ret)
+}
+
+// hmacSha256Func hmac_sha256(data, key) returns the hex HMAC-SHA256 of data.
+var hmacSha256Func = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	for _, arg := range args {
+		if arg.Type() == Undefined {
+			return arg
+		}
+	}
+	data, err := dataArg(args[0])
+	if err != nil {
+		return errorNode(err)
+	}
+	key, err := dataArg(args[1])
+	if err != nil {
+		return errorNode(err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return StringNode(hex.EncodeToString(mac.Sum(nil)))
+}
+
+// checksumFunc adapts a checksum of data to an Int.
+func checksumFunc(sum func([]byte) int64) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		if args[0].Type() == Undefined {
+			return args[0]
+		}
+		data, err := dataArg(args[0])
+		if err != nil {
+			return errorNode(err)
+		}
+		return IntNode(sum(data))
+	}
+}
+
+var (
+	// crc16Func crc16(x) returns the CRC-16/MODBUS of x, as used by Modbus RTU frames.
+	crc16Func = checksumFunc(func(data []byte) int64 { return int64(crc16Modbus(data)) })
+	// crc32Func crc32(x) returns the CRC-32 (IEEE) of x.
+	crc32Func = checksumFunc(func(data []byte) int64 { return int64(crc32.ChecksumIEEE(data)) })
+)
+
+// crc16Modbus returns the CRC-16/MODBUS of data, reflected polynomial 0xA001 from 0xFFFF.
+func crc16Modbus(data []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 == 1 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodingFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"frame": BytesNode{0x01, 0x03, 0x00, 0x00, 0x00, 0x0a},
+		"dev":   New(`{"id":"d1"}`),
+	}, nil)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`base64('hello?')`, "aGVsbG8/"},
+		{`base64(dev)`, "eyJpZCI6ImQxIn0="},
+		{`base64url('hello?')`, "aGVsbG8_"},
+		{`base64_decode('aGVsbG8/')`, "base64:aGVsbG8/"},
+		{`base64_decode('aGVsbG8_')`, "base64:aGVsbG8/"},
+		{`base64_decode('YQ')`, "base64:YQ=="},
+		{`hex_encode(frame)`, "01030000000a"},
+		{`hex_encode('é')`, "c3a9"},
+		{`hex_decode('FF00')`, "base64:/wA="},
+		{`url_encode('a b&c=d/é')`, "a+b%26c%3Dd%2F%C3%A9"},
+		{`url_decode('a+b%26c')`, "a b&c"},
+		{`md5('abc')`, "900150983cd24fb0d6963f7d28e17f72"},
+		{`sha1('abc')`, "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{`sha256('abc')`, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{`hmac_sha256('The quick brown fox jumps over the lazy dog', 'key')`, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"},
+		{`crc16(frame)`, "52677"},
+		{`crc32('123456789')`, "3421780262"},
+		{`md5(missing)`, ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+
+	errs := []struct {
+		expr string
+		err  error
+	}{
+		{`hex_decode('xyz')`, ErrInvalidEncoding},
+		{`base64_decode('a$b')`, ErrInvalidEncoding},
+		{`url_decode('%zz')`, ErrInvalidEncoding},
+		{`sha256(json_parse('null'))`, ErrInvalidArgument},
+	}
+	for _, tt := range errs {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), tt.err), tt.expr)
+	}
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+)
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+type geoPoint struct {
+	lat, lng float64
+}
+
+// pointArgs reads a point from the head of args, either as two numbers lat, lng
+// or as a single object: {"lat":..,"lng":..} (or "lon"), a GeoJSON Point or Feature,
+// or a GeoJSON position [lng, lat]. It returns the rest of args.
+func pointArgs(args []Node) (geoPoint, []Node, Node) {
+	if len(args) == 0 {
+		return geoPoint{}, nil, UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined {
+		return geoPoint{}, nil, args[0]
+	}
+
+	var p geoPoint
+	if cc, ok := docArg(args[0]); ok {
+		if p, ok = pointOf(gjson.ParseBytes(cc.value)); !ok {
+			return geoPoint{}, nil, errorNode(fmt.Errorf("%w: point %s", ErrInvalidArgument, cc.value))
+		}
+		args = args[1:]
+	} else {
+		if len(args) < 2 {
+			return geoPoint{}, nil, UNDEFINED_RESULT
+		}
+		if args[1].Type() == Undefined {
+			return geoPoint{}, nil, args[1]
+		}
+		lat, ok1 := floatArg(args[0])
+		lng, ok2 := floatArg(args[1])
+		if !ok1 || !ok2 {
+			return geoPoint{}, nil, errorNode(fmt.Errorf("%w: point (%s, %s)", ErrInvalidArgument, args[0].Type(), args[1].Type()))
+		}
+		p, args = geoPoint{lat: lat, lng: lng}, args[2:]
+	}
+
+	if !(p.lat >= -90 && p.lat <= 90 && p.lng >= -180 && p.lng <= 180) {
+		return geoPoint{}, nil, errorNode(fmt.Errorf("%w: point (%v, %v)", ErrOutOfRange, p.lat, p.lng))
+	}
+	return p, args, nil
+}
+
+// pointOf returns the point of a json value.
+func pointOf(doc gjson.Result) (geoPoint, bool) {
+	if doc.Get("type").String() == "Feature" {
+		doc = doc.Get("geometry")
+	}
+	if doc.Get("type").String() == "Point" {
+		doc = doc.Get("coordinates")
+	}
+	if doc.IsArray() {
+		return positionOf(doc)
+	}
+	lat, lng := doc.Get("lat"), doc.Get("lng")
+	if !lng.Exists() {
+		lng = doc.Get("lon")
+	}
+	if lat.Type != gjson.Number || lng.Type != gjson.Number {
+		return geoPoint{}, false
+	}
+	return geoPoint{lat: lat.Float(), lng: lng.Float()}, true
+}
+
+// positionOf returns the point of a GeoJSON position, longitude first.
+func positionOf(pos gjson.Result) (geoPoint, bool) {
+	coords := pos.Array()
+	if len(coords) < 2 || coords[0].Type != gjson.Number || coords[1].Type != gjson.Number {
+		return geoPoint{}, false
+	}
+	return geoPoint{lat: coords[1].Float(), lng: coords[0].Float()}, true
+}
+
+// geoPolygon is an outer ring followed by its holes.
+type geoPolygon [][]geoPoint
+
+// polygonsArg returns the polygons of an area argument: a GeoJSON Polygon,
+// MultiPolygon or Feature of them, or an array of points as a single ring.
+func polygonsArg(node Node) ([]geoPolygon, Node) {
+	if node.Type() == Undefined {
+		return nil, node
+	}
+	cc, ok := docArg(node)
+	if !ok {
+		return nil, errorNode(fmt.Errorf("%w: polygon %s", ErrInvalidArgument, node.Type()))
+	}
+	doc := gjson.ParseBytes(cc.value)
+	if doc.Get("type").String() == "Feature" {
+		doc = doc.Get("geometry")
+	}
+
+	var polygons []geoPolygon
+	switch {
+	case doc.Get("type").String() == "Polygon":
+		polygon, ok := polygonOf(doc.Get("coordinates"))
+		if !ok {
+			return nil, errorNode(fmt.Errorf("%w: polygon %s", ErrInvalidArgument, cc.value))
+		}
+		polygons = append(polygons, polygon)
+	case doc.Get("type").String() == "MultiPolygon":
+		for _, coords := range doc.Get("coordinates").Array() {
+			polygon, ok := polygonOf(coords)
+			if !ok {
+				return nil, errorNode(fmt.Errorf("%w: polygon %s", ErrInvalidArgument, cc.value))
+			}
+			polygons = append(polygons, polygon)
+		}
+	case doc.IsArray():
+		ring, ok := ringOf(doc)
+		if !ok {
+			return nil, errorNode(fmt.Errorf("%w: polygon %s", ErrInvalidArgument, cc.value))
+		}
+		polygons = append(polygons, geoPolygon{ring})
+	}
+	if len(polygons) == 0 {
+		return nil, errorNode(fmt.Errorf("%w: polygon %s", ErrInvalidArgument, cc.value))
+	}
+	return polygons, nil
+}
+
+// polygonOf returns the polygon of the rings of GeoJSON Polygon coordinates.
+func polygonOf(coords gjson.Result) (geoPolygon, bool) {
+	var polygon geoPolygon
+	for _, r := range coords.Array() {
+		ring, ok := ringOf(r)
+		if !ok {
+			return nil, false
+		}
+		polygon = append(polygon, ring)
+	}
+	return polygon, len(polygon) > 0
+}
+
+// ringOf returns the points of a ring, a ring has at least 3 points.
+func ringOf(coords gjson.Result) ([]geoPoint, bool) {
+	var ring []geoPoint
+	for _, pos := range coords.Array() {
+		p, ok := pointOf(pos)
+		if !ok {
+			return nil, false
+		}
+		ring = append(ring, p)
+	}
+	return ring, len(ring) >= 3
+}
+
+// contains reports whether p is in the outer ring and in none of the holes.
+func (polygon geoPolygon) contains(p geoPoint) bool {
+	if !inRing(p, polygon[0]) {
+		return false
+	}
+	for _, hole := range polygon[1:] {
+		if inRing(p, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+// inRing is the even-odd rule on the plane of longitude and latitude,
+// which is accurate enough for the geofences of a site or a city.
+func inRing(p geoPoint, ring []geoPoint) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.lat > p.lat) != (b.lat > p.lat) &&
+			p.lng < (b.lng-a.lng)*(p.lat-a.lat)/(b.lat-a.lat)+a.lng {
+			in = !in
+		}
+	}
+	return in
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// twoPointsFunc adapts a function of two points, each given as lat, lng or as an object.
+func twoPointsFunc(fn func(p1, p2 geoPoint) float64) ContextFunc {
+	return func(args ...Node) Node {
+		p1, rest, bad := pointArgs(args)
+		if bad != nil {
+			return bad
+		}
+		p2, rest, bad := pointArgs(rest)
+		if bad != nil {
+			return bad
+		}
+		if len(rest) != 0 {
+			return UNDEFINED_RESULT
+		}
+		return FloatNode(fn(p1, p2))
+	}
+}
+
+var (
+	// geoDistanceFunc geo_distance(lat1, lng1, lat2, lng2) returns the great-circle
+	// distance in meters between two points by the haversine formula.
+	geoDistanceFunc = twoPointsFunc(func(p1, p2 geoPoint) float64 {
+		dlat, dlng := radians(p2.lat-p1.lat), radians(p2.lng-p1.lng)
+		h := math.Sin(dlat/2)*math.Sin(dlat/2) +
+			math.Cos(radians(p1.lat))*math.Cos(radians(p2.lat))*math.Sin(dlng/2)*math.Sin(dlng/2)
+		return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+	})
+	// geoBearingFunc geo_bearing(lat1, lng1, lat2, lng2) returns the initial bearing
+	// from the first point to the second in degrees clockwise from north, in [0, 360).
+	geoBearingFunc = twoPointsFunc(func(p1, p2 geoPoint) float64 {
+		phi1, phi2, dlng := radians(p1.lat), radians(p2.lat), radians(p2.lng-p1.lng)
+		y := math.Sin(dlng) * math.Cos(phi2)
+		x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dlng)
+		return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+	})
+)
+
+// geoWithinFunc geo_within(point, polygon) reports whether point is inside polygon,
+// the point may also be given as lat, lng.
+var geoWithinFunc = func(args ...Node) Node {
+	p, rest, bad := pointArgs(args)
+	if bad != nil {
+		return bad
+	}
+	if len(rest) != 1 {
+		return UNDEFINED_RESULT
+	}
+	polygons, bad := polygonsArg(rest[0])
+	if bad != nil {
+		return bad
+	}
+	for _, polygon := range polygons {
+		if polygon.contains(p) {
+			return BoolNode(true)
+		}
+	}
+	return BoolNode(false)
+}
+
+// geoBboxFunc geo_bbox(point, min_lat, min_lng, max_lat, max_lng) reports whether
+// point is inside the bounding box, a box with min_lng > max_lng crosses the antimeridian.
+var geoBboxFunc = func(args ...Node) Node {
+	p, rest, bad := pointArgs(args)
+	if bad != nil {
+		return bad
+	}
+	if len(rest) != 4 {
+		return UNDEFINED_RESULT
+	}
+	var box [4]float64
+	for i, arg := range rest {
+		f, ok := floatArg(arg)
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		box[i] = f
+	}
+	minLat, minLng, maxLat, maxLng := box[0], box[1], box[2], box[3]
+	if p.lat < minLat || p.lat > maxLat {
+		return BoolNode(false)
+	}
+	if minLng <= maxLng {
+		return BoolNode(p.lng >= minLng && p.lng <= maxLng)
+	}
+	return BoolNode(p.lng >= minLng || p.lng <= maxLng)
+}
+
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// geohashEncodeFunc geohash_encode(point[, precision]) returns the geohash of point
+// with precision characters, 12 by default. The point may also be given as lat, lng.
+var geohashEncodeFunc = func(args ...Node) Node {
+	p, rest, bad := pointArgs(args)
+	if bad != nil {
+		return bad
+	}
+	precision := 12
+	switch len(rest) {
+	case 0:
+	case 1:
+		n, ok := intArg(rest[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		if n < 1 || n > 12 {
+			return errorNode(fmt.Errorf("%w: geohash precision %d", ErrOutOfRange, n))
+		}
+		precision = n
+	default:
+		return UNDEFINED_RESULT
+	}
+	return StringNode(geohashEncode(p, precision))
+}
+
+func geohashEncode(p geoPoint, precision int) string {
+	lat, lng := [2]float64{-90, 90}, [2]float64{-180, 180}
+	var sb strings.Builder
+	even, bit, ch := true, 0, 0
+	for sb.Len() < precision {
+		// the bits interleave longitude and latitude, longitude first.
+		rng, v := &lat, p.lat
+		if even {
+			rng, v = &lng, p.lng
+		}
+		mid := (rng[0] + rng[1]) / 2
+		ch <<= 1
+		if v >= mid {
+			ch |= 1
+			rng[0] = mid
+		} else {
+			rng[1] = mid
+		}
+		even = !even
+		if bit++; bit == 5 {
+			sb.WriteByte(geohashBase32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+// geohashDecodeFunc geohash_decode(hash) returns the center of the cell of hash
+// as an object {"lat":..,"lng":..}.
+var geohashDecodeFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined {
+		return args[0]
+	}
+	hash, ok := stringArg(args[0])
+	if !ok {
+		return errorNode(fmt.Errorf("%w: %s", ErrInvalidArgument, args[0].Type()))
+	}
+	p, err := geohashDecode(hash)
+	if err != nil {
+		return errorNode(err)
+	}
+	ret := New("{}")
+	ret.Set("lat", FloatNode(p.lat))
+	ret.Set("lng", FloatNode(p.lng))
+	return docResult(ret)
+}
+
+func geohashDecode(hash string) (geoPoint, error) {
+	if hash == "" {
+		return geoPoint{}, fmt.Errorf("%w: geohash %q", ErrInvalidEncoding, hash)
+	}
+	lat, lng := [2]float64{-90, 90}, [2]float64{-180, 180}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		ch := strings.IndexRune(geohashBase32, c)
+		if ch < 0 {
+			return geoPoint{}, fmt.Errorf("%w: geohash %q", ErrInvalidEncoding, hash)
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			rng := &lat
+			if even {
+				rng = &lng
+			}
+			mid := (rng[0] + rng[1]) / 2
+			if ch&mask != 0 {
+				rng[0] = mid
+			} else {
+				rng[1] = mid
+			}
+			even = !even
+		}
+	}
+	return geoPoint{lat: (lat[0] + lat[1]) / 2, lng: (lng[0] + lng[1]) / 2}, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"truck":  New(`{"id":"t1","pos":{"lat":48.8566,"lng":2.3522}}`),
+		"depot":  New(`{"type":"Point","coordinates":[-0.1278,51.5074]}`),
+		"fence":  New(`{"type":"Polygon","coordinates":[[[2,48],[3,48],[3,49],[2,49],[2,48]],[[2.34,48.8],[2.36,48.8],[2.36,48.9],[2.34,48.9],[2.34,48.8]]]}`),
+		"square": New(`[{"lat":0,"lon":0},{"lat":0,"lon":10},{"lat":10,"lon":10},{"lat":10,"lon":0}]`),
+	}, nil)
+
+	floats := []struct {
+		expr  string
+		want  float64
+		delta float64
+	}{
+		{`geo_distance(48.8566, 2.3522, 51.5074, -0.1278)`, 343556, 100},
+		{`geo_distance(truck.pos, depot)`, 343556, 100},
+		{`geo_distance(truck.pos, 51.5074, -0.1278)`, 343556, 100},
+		{`geo_distance(0, 0, 0, 1)`, 111195, 1},
+		{`geo_distance(truck.pos, truck.pos)`, 0, 0},
+		{`geo_bearing(0, 0, 1, 0)`, 0, 1e-9},
+		{`geo_bearing(0, 0, 0, 1)`, 90, 1e-9},
+		{`geo_bearing(0, 0, -1, 0)`, 180, 1e-9},
+		{`geo_bearing(0, 0, 0, -1)`, 270, 1e-9},
+		{`geo_bearing(truck.pos, depot)`, 330, 1},
+	}
+	for _, tt := range floats {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		ret, ok := eval(MutilContext{DefaultValue, ctx}, expr).(FloatNode)
+		assert.True(t, ok, tt.expr)
+		assert.InDelta(t, tt.want, float64(ret), tt.delta, tt.expr)
+	}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`geo_within(truck.pos, fence)`, "false"},
+		{`geo_within(48.5, 2.5, fence)`, "true"},
+		{`geo_within(50, 2.5, fence)`, "false"},
+		{`geo_within('{"lat":5,"lng":5}', square)`, "true"},
+		{`geo_within(5, 11, square)`, "false"},
+		{`geo_within(depot, '[[-1,51],[1,51],[0,52]]')`, "true"},
+		{`geo_within(48.5, 2.5, '{"type":"Feature","geometry":{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1]]],[[[2,48],[3,48],[3,49]]]]}}')`, "true"},
+		{`geo_bbox(truck.pos, 48, 2, 49, 3)`, "true"},
+		{`geo_bbox(48.8566, 2.3522, 48, 3, 49, 4)`, "false"},
+		{`geo_bbox(0, 179.5, -1, 179, 1, -179)`, "true"},
+		{`geo_bbox(0, -179.5, -1, 179, 1, -179)`, "true"},
+		{`geo_bbox(0, 0, -1, 179, 1, -179)`, "false"},
+		{`geohash_encode(57.64911, 10.40744, 11)`, "u4pruydqqvj"},
+		{`geohash_encode(truck.pos, 5)`, "u09tv"},
+		{`geohash_encode(depot)`, "gcpvj0duq533"},
+		{`geohash_decode('u4pruydqqvj')`, `{"lat":57.64911063015461,"lng":10.407439693808556}`},
+		{`geohash_encode(json_get(geohash_decode('gcpvj0duq533'), 'lat'), json_get(geohash_decode('gcpvj0duq533'), 'lng'))`, "gcpvj0duq533"},
+		{`geo_distance(missing, depot)`, ""},
+		{`geo_within(truck.pos)`, ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+
+	errs := []struct {
+		expr string
+		err  error
+	}{
+		{`geo_distance(91, 0, 0, 0)`, ErrOutOfRange},
+		{`geo_distance('{"x":1}', depot)`, ErrInvalidArgument},
+		{`geo_within(truck.pos, '[[0,0],[1,1]]')`, ErrInvalidArgument},
+		{`geo_within(truck.pos, 'nowhere')`, ErrInvalidArgument},
+		{`geohash_encode(0, 0, 13)`, ErrOutOfRange},
+		{`geohash_decode('u4a')`, ErrInvalidEncoding},
+	}
+	for _, tt := range errs {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		ret := eval(MutilContext{DefaultValue, ctx}, expr)
+		assert.True(t, errors.Is(ret.Error(), tt.err), tt.expr)
+	}
+}
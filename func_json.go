@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"bytes"
+
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
+)
+
+// docArg returns a copy of an object or array argument, json text in a string
+// is parsed. The functions modify the copy, never the argument.
+func docArg(node Node) (*Collect, bool) {
+	if s, ok := node.(StringNode); ok {
+		node = New(string(s))
+	}
+	cc, ok := structuralNode(node).(JSONNode)
+	if !ok || (cc.datatype != Object && cc.datatype != Array) {
+		return nil, false
+	}
+	return &Collect{value: append([]byte(nil), cc.value...), datatype: cc.datatype}, true
+}
+
+// docResult returns the node of a modified collect, or its error.
+func docResult(cc *Collect) Node {
+	if cc.err != nil {
+		return errorNode(cc.err)
+	}
+	return cc.Node()
+}
+
+// jsonParseFunc json_parse(s) parse the json text s.
+var jsonParseFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	s, ok := stringArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	node, err := UnmarshalNode([]byte(s))
+	if err != nil {
+		return errorNode(err)
+	}
+	return node
+}
+
+// jsonGetFunc json_get(doc, path) returns the value at path in doc.
+var jsonGetFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok1 := docArg(args[0])
+	path, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	return cc.Get(path).Node()
+}
+
+// jsonSetFunc json_set(doc, path, value) returns doc with value set at path.
+var jsonSetFunc = func(args ...Node) Node {
+	if len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok1 := docArg(args[0])
+	path, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 || args[2].Type() == Undefined {
+		return UNDEFINED_RESULT
+	}
+	cc.Set(path, args[2])
+	return docResult(cc)
+}
+
+// jsonDeleteFunc json_delete(doc, path, ...) returns doc without the values at the paths.
+var jsonDeleteFunc = func(args ...Node) Node {
+	if len(args) < 2 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok := docArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	for _, arg := range args[1:] {
+		path, ok := stringArg(arg)
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		cc.Del(path)
+	}
+	return docResult(cc)
+}
+
+// keysFunc keys(object) returns the array of the keys of object.
+var keysFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok := docArg(args[0])
+	if !ok || cc.datatype != Object {
+		return UNDEFINED_RESULT
+	}
+	keys := make([]string, 0)
+	gjson.ParseBytes(cc.value).ForEach(func(key, _ gjson.Result) bool {
+		keys = append(keys, key.String())
+		return true
+	})
+	return FromGo(keys)
+}
+
+// valuesFunc values(object) returns the array of the values of object.
+var valuesFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok := docArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	raws := make([][]byte, 0)
+	gjson.ParseBytes(cc.value).ForEach(func(_, value gjson.Result) bool {
+		raws = append(raws, []byte(value.Raw))
+		return true
+	})
+	raw := append(append([]byte("["), bytes.Join(raws, []byte(","))...), ']')
+	return JSONNode{value: raw, datatype: Array}
+}
+
+// mergeFunc merge(object, ...) returns the objects merged from left to right,
+// a later value replaces an earlier one.
+var mergeFunc = func(args ...Node) Node {
+	if len(args) < 1 {
+		return UNDEFINED_RESULT
+	}
+	ret, ok := docArg(args[0])
+	if !ok || ret.datatype != Object {
+		return UNDEFINED_RESULT
+	}
+	for _, arg := range args[1:] {
+		cc, ok := docArg(arg)
+		if !ok || cc.datatype != Object {
+			return UNDEFINED_RESULT
+		}
+		ret = ret.Merge(cc)
+	}
+	return docResult(ret)
+}
+
+// arrayPathFunc adapts a Collect method on an array and a path.
+func arrayPathFunc(fn func(cc *Collect, path string) *Collect) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 2 {
+			return UNDEFINED_RESULT
+		}
+		cc, ok1 := docArg(args[0])
+		path, ok2 := stringArg(args[1])
+		if !ok1 || !ok2 || cc.datatype != Array {
+			return UNDEFINED_RESULT
+		}
+		return docResult(fn(cc, path))
+	}
+}
+
+var (
+	// groupByFunc group_by(array, path) returns an object of the arrays of elements with the same value at path.
+	groupByFunc = arrayPathFunc((*Collect).GroupBy)
+	// keyByFunc key_by(array, path) returns an object of the elements keyed by the value at path.
+	keyByFunc = arrayPathFunc((*Collect).KeyBy)
+)
+
+// sortByFunc sort_by(array, path[, 'desc']) returns array sorted by the values at path.
+var sortByFunc = func(args ...Node) Node {
+	if len(args) != 2 && len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	cc, ok1 := docArg(args[0])
+	path, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 || cc.datatype != Array {
+		return UNDEFINED_RESULT
+	}
+	less := LessBy(path)
+	if len(args) == 3 {
+		switch order, _ := stringArg(args[2]); order {
+		case "asc":
+		case "desc":
+			less = func(p1, p2 *Collect) bool { return LessBy(path)(p2, p1) }
+		default:
+			return UNDEFINED_RESULT
+		}
+	}
+	cc.SortBy(less)
+	return docResult(cc)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONFunc(t *testing.T) {
+	dev := New(`{"id":"d1","status":{"online":true},"temps":[3,1,2]}`)
+	ctx := NewMapContext(map[string]Node{
+		"dev":     dev,
+		"payload": StringNode(`{"ts":1,"values":{"a":1}}`),
+		"items":   New(`[{"k":"b","n":2},{"k":"a","n":1},{"k":"b","n":3}]`),
+	}, nil)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`json_parse('[1,"a"]')`, `[1,"a"]`},
+		{`json_parse('12')`, "12"},
+		{`json_get(payload, 'values.a')`, "1"},
+		{`json_get(dev, 'status')`, `{"online":true}`},
+		{`json_get(dev, 'missing')`, ""},
+		{`json_set(dev, 'status.online', false)`, `{"id":"d1","status":{"online":false},"temps":[3,1,2]}`},
+		{`json_set(payload, 'unit', 'C')`, `{"ts":1,"values":{"a":1},"unit":"C"}`},
+		{`json_delete(dev, 'status', 'temps')`, `{"id":"d1"}`},
+		{`keys(dev)`, `["id","status","temps"]`},
+		{`values(json_get(dev, 'status'))`, `[true]`},
+		{`length(dev)`, "3"},
+		{`length(dev.temps)`, "3"},
+		{`merge(dev, '{"id":"d2","x":1}')`, `{"id":"d2","status":{"online":true},"temps":[3,1,2],"x":1}`},
+		{`group_by(items, 'k')`, `{"b":[{"k":"b","n":2},{"k":"b","n":3}],"a":[{"k":"a","n":1}]}`},
+		{`key_by(items, 'k')`, `{"b":{"k":"b","n":3},"a":{"k":"a","n":1}}`},
+		{`sort_by(items, 'n')`, `[{"k":"a","n":1},{"k":"b","n":2},{"k":"b","n":3}]`},
+		{`sort_by(items, 'n', 'desc')`, `[{"k":"b","n":3},{"k":"b","n":2},{"k":"a","n":1}]`},
+		{`keys(items)`, ""},
+		{`merge(dev, 1)`, ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+	// the arguments are not modified.
+	assert.Equal(t, `{"id":"d1","status":{"online":true},"temps":[3,1,2]}`, dev.String())
+
+	expr, err := ParseExpr(`json_parse('{')`)
+	assert.NoError(t, err)
+	assert.Error(t, eval(MutilContext{DefaultValue, ctx}, expr).Error())
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"math"
+)
+
+// numberArg returns a numeric argument as IntNode, FloatNode or DecimalNode,
+// numeric strings are converted.
+func numberArg(node Node) (Node, bool) {
+	switch node := node.(type) {
+	case IntNode, FloatNode, DecimalNode:
+		return node, true
+	case BoolNode:
+		return UNDEFINED_RESULT, false
+	}
+	switch num := node.To(Number).(type) {
+	case IntNode, FloatNode, DecimalNode:
+		return num, true
+	}
+	return UNDEFINED_RESULT, false
+}
+
+// floatArg returns the value of a numeric argument as float64.
+func floatArg(node Node) (float64, bool) {
+	num, ok := numberArg(node)
+	if !ok {
+		return 0, false
+	}
+	switch num := num.(type) {
+	case IntNode:
+		return float64(num), true
+	case FloatNode:
+		return float64(num), true
+	case DecimalNode:
+		return num.Float64(), true
+	}
+	return 0, false
+}
+
+// floatResult returns f, a NaN or infinite result is out of the domain of the function.
+func floatResult(f float64) Node {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return errorNode(ErrOutOfDomain)
+	}
+	return FloatNode(f)
+}
+
+// floatFunc adapts a float function of one argument, the result is a Float
+// whatever the type of the argument.
+func floatFunc(fn func(float64) float64) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		x, ok := floatArg(args[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		return floatResult(fn(x))
+	}
+}
+
+var (
+	sqrtFunc  = floatFunc(math.Sqrt)
+	expFunc   = floatFunc(math.Exp)
+	lnFunc    = floatFunc(math.Log)
+	log10Func = floatFunc(math.Log10)
+	sinFunc   = floatFunc(math.Sin)
+	cosFunc   = floatFunc(math.Cos)
+	roundFunc = roundingFunc(RoundHalfUp)
+	floorFunc = roundingFunc(RoundFloor)
+	ceilFunc  = roundingFunc(RoundCeiling)
+	truncFunc = roundingFunc(RoundDown)
+	minFunc   = extremeFunc(-1)
+	maxFunc   = extremeFunc(1)
+)
+
+// absFunc abs(x) returns the absolute value of x, in the type of x.
+var absFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	num, ok := numberArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	return absNode(num)
+}
+
+func absNode(num Node) Node {
+	switch num := num.(type) {
+	case IntNode:
+		if num == math.MinInt64 {
+			return errorNode(ErrIntegerOverflow)
+		}
+		if num < 0 {
+			return -num
+		}
+		return num
+	case FloatNode:
+		return FloatNode(math.Abs(float64(num)))
+	case DecimalNode:
+		if num.Sign() < 0 {
+			return num.Neg()
+		}
+		return num
+	}
+	return UNDEFINED_RESULT
+}
+
+// roundingFunc adapts a rounding mode to f(x[, n]), which rounds x to n
+// fractional digits, 0 by default. An integer is only rounded by a negative n.
+func roundingFunc(mode RoundingMode) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 && len(args) != 2 {
+			return UNDEFINED_RESULT
+		}
+		n := 0
+		if len(args) == 2 {
+			digits, ok := args[1].To(Int).(IntNode)
+			if !ok {
+				return UNDEFINED_RESULT
+			}
+			n = int(digits)
+		}
+		ret := roundNodeMode(args[0], n, mode)
+		if num, _ := numberArg(args[0]); num != nil && num.Type() == Float && len(args) == 1 {
+			// without n a float stays a float, there are no digits to keep.
+			return ret.To(Float)
+		}
+		return ret
+	}
+}
+
+// roundNode rounds node half away from zero to n fractional digits,
+// a float becomes a decimal which keeps the n digits.
+func roundNode(node Node, n int) Node {
+	return roundNodeMode(node, n, RoundHalfUp)
+}
+
+// roundNodeMode rounds node to n fractional digits, n beyond MaxDecimalScale is out of range.
+func roundNodeMode(node Node, n int, mode RoundingMode) Node {
+	num, ok := numberArg(node)
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	if n < -MaxDecimalScale || n > MaxDecimalScale {
+		return errorNode(fmt.Errorf("%w: %d digits", ErrOutOfRange, n))
+	}
+	switch num := num.(type) {
+	case IntNode:
+		if n >= 0 {
+			return num
+		}
+		// e.g. 1234 to -2 digits is 1200, the result may overflow an Int.
+		return NewDecimalFromInt(int64(num)).Round(int32(n), mode).To(Int)
+	case FloatNode:
+		return roundFloat(float64(num), n, mode)
+	case DecimalNode:
+		return num.Round(int32(n), mode)
+	}
+	return UNDEFINED_RESULT
+}
+
+// powFunc pow(x, y) returns x**y, an Int or a Decimal raised to a non-negative
+// integer is exact, otherwise the result is a Float.
+var powFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	x, ok1 := numberArg(args[0])
+	y, ok2 := numberArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	if exp, ok := y.(IntNode); ok && exp >= 0 {
+		switch x := x.(type) {
+		case IntNode:
+			return powInt(int64(x), int64(exp))
+		case DecimalNode:
+			return powDecimal(x, int64(exp))
+		}
+	}
+	xf, _ := floatArg(x)
+	yf, _ := floatArg(y)
+	return floatResult(math.Pow(xf, yf))
+}
+
+// powInt returns x**n by squaring, it reports ErrIntegerOverflow.
+func powInt(x, n int64) Node {
+	ret := int64(1)
+	for ok := true; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			if ret, ok = mulInt64(ret, x); !ok {
+				return errorNode(ErrIntegerOverflow)
+			}
+		}
+		if n > 1 {
+			if x, ok = mulInt64(x, x); !ok {
+				return errorNode(ErrIntegerOverflow)
+			}
+		}
+	}
+	return IntNode(ret)
+}
+
+// powDecimal returns x**n by squaring, n is limited to MaxDecimalScale.
+func powDecimal(x DecimalNode, n int64) Node {
+	if n > MaxDecimalScale {
+		return errorNode(fmt.Errorf("%w: exponent %d", ErrOutOfRange, n))
+	}
+	ret := NewDecimalFromInt(1)
+	for ; n > 0; n >>= 1 {
+		var err error
+		if n&1 == 1 {
+			if ret, err = ret.Mul(x); err != nil {
+				return errorNode(err)
+			}
+		}
+		if n > 1 {
+			if x, err = x.Mul(x); err != nil {
+				return errorNode(err)
+			}
+		}
+	}
+	return ret
+}
+
+// atan2Func atan2(y, x) returns the arc tangent of y/x in radians.
+var atan2Func = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	y, ok1 := floatArg(args[0])
+	x, ok2 := floatArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	return floatResult(math.Atan2(y, x))
+}
+
+// extremeFunc adapts min(x, ...) and max(x, ...), the arguments may also be a single array.
+// The extreme number is returned as it is.
+func extremeFunc(sign int) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) == 1 {
+			if elems, ok := arrayElements(args[0]); ok {
+				args = elems
+			}
+		}
+		var ret Node
+		for _, arg := range args {
+			num, ok := numberArg(arg)
+			if !ok {
+				return UNDEFINED_RESULT
+			}
+			if ret == nil || Compare(num, ret)*sign > 0 {
+				ret = num
+			}
+		}
+		if ret == nil {
+			return UNDEFINED_RESULT
+		}
+		return ret
+	}
+}
+
+// clampFunc clamp(x, lo, hi) limits x to the range [lo, hi], an argument which is
+// not a number or an empty range is an invalid argument.
+var clampFunc = func(args ...Node) Node {
+	if len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	nums := make([]Node, 3)
+	for i, arg := range args {
+		if arg.Type() == Undefined {
+			return arg
+		}
+		num, ok := numberArg(arg)
+		if !ok {
+			return errorNode(fmt.Errorf("%w: argument %d of clamp is %s, not a number", ErrInvalidArgument, i+1, arg.Type()))
+		}
+		nums[i] = num
+	}
+	x, lo, hi := nums[0], nums[1], nums[2]
+	switch {
+	case Compare(lo, hi) > 0:
+		return errorNode(fmt.Errorf("%w: clamp range [%s, %s] is empty", ErrInvalidArgument, lo, hi))
+	case Compare(x, lo) < 0:
+		return lo
+	case Compare(x, hi) > 0:
+		return hi
+	}
+	return x
+}
+
+// signFunc sign(x) returns -1, 0 or 1 by the sign of x.
+var signFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	num, ok := numberArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	if f, ok := num.(FloatNode); ok && math.IsNaN(float64(f)) {
+		return UNDEFINED_RESULT
+	}
+	return IntNode(Compare(num, IntNode(0)))
+}
+
+var piFunc = func(args ...Node) Node {
+	if len(args) != 0 {
+		return UNDEFINED_RESULT
+	}
+	return FloatNode(math.Pi)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMathFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"neg":    FloatNode(-0.5),
+		"temp":   StringNode("-2.5"),
+		"price":  mustDecimal("-1.25"),
+		"values": New(`[3, -1.5, 2]`),
+	}, nil)
+	tests := []struct {
+		expr string
+		want Node
+	}{
+		{`abs(neg)`, FloatNode(0.5)},
+		{`abs(-3)`, IntNode(3)},
+		{`abs(price)`, mustDecimal("1.25")},
+		{`abs(temp)`, FloatNode(2.5)},
+		{`round(temp)`, FloatNode(-3)},
+		{`round(2.675, 2)`, mustDecimal("2.68")},
+		{`round(2.5, 2)`, mustDecimal("2.50")},
+		{`round(2.5)`, FloatNode(3)},
+		{`round(50.1234, 3)`, mustDecimal("50.123")},
+		{`round(50.1, 3)`, mustDecimal("50.100")},
+		{`round(7, 2)`, IntNode(7)},
+		{`round('1.005', 2)`, mustDecimal("1.01")},
+		{`round(decimal('1.005'), 2)`, mustDecimal("1.01")},
+		{`floor(temp, 2)`, mustDecimal("-2.50")},
+		{`round(price, 1)`, mustDecimal("-1.3")},
+		{`floor(temp)`, FloatNode(-3)},
+		{`ceil(temp)`, FloatNode(-2)},
+		{`trunc(temp)`, FloatNode(-2)},
+		{`floor(price, 1)`, mustDecimal("-1.3")},
+		{`ceil(7)`, IntNode(7)},
+		{`round(1234, -2)`, IntNode(1200)},
+		{`round(1250, -2)`, IntNode(1300)},
+		{`floor(-1234, -2)`, IntNode(-1300)},
+		{`ceil(1234, -2)`, IntNode(1300)},
+		{`trunc(1234, 2)`, IntNode(1234)},
+		{`sqrt(16)`, FloatNode(4)},
+		{`pow(2, 10)`, IntNode(1024)},
+		{`pow(price, 2)`, mustDecimal("1.5625")},
+		{`pow(4, 0.5)`, FloatNode(2)},
+		{`pow(2, -1)`, FloatNode(0.5)},
+		{`exp(0)`, FloatNode(1)},
+		{`ln(1)`, FloatNode(0)},
+		{`log10(1000)`, FloatNode(3)},
+		{`sin(0)`, FloatNode(0)},
+		{`cos(0)`, FloatNode(1)},
+		{`atan2(0, 1)`, FloatNode(0)},
+		{`min(3, neg, 2)`, FloatNode(-0.5)},
+		{`max(3, price, 2)`, IntNode(3)},
+		{`min(values)`, FloatNode(-1.5)},
+		{`max(values)`, IntNode(3)},
+		{`clamp(12, 0, 10)`, IntNode(10)},
+		{`clamp(neg, 0, 10)`, IntNode(0)},
+		{`clamp(missing, 0, 10)`, UNDEFINED_RESULT},
+		{`sign(price)`, IntNode(-1)},
+		{`sign(0.0)`, IntNode(0)},
+		{`pi()`, FloatNode(3.141592653589793)},
+		{`sqrt('x')`, UNDEFINED_RESULT},
+		{`round('abc')`, UNDEFINED_RESULT},
+		{`max()`, UNDEFINED_RESULT},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr), tt.expr)
+	}
+
+	errs := []struct {
+		expr string
+		err  error
+	}{
+		{`sqrt(-1)`, ErrOutOfDomain},
+		{`ln(0)`, ErrOutOfDomain},
+		{`pow(10, 19)`, ErrIntegerOverflow},
+		{`clamp(5, 10, 1)`, ErrInvalidArgument},
+		{`clamp('x', 0, 10)`, ErrInvalidArgument},
+		{`clamp(5, 0, true)`, ErrInvalidArgument},
+		{`round(9223372036854775807, -1)`, ErrIntegerOverflow},
+		{`round(2.5, 1001)`, ErrOutOfRange},
+		{`round(price, 2147483648)`, ErrOutOfRange},
+		{`floor(price, -1001)`, ErrOutOfRange},
+		{`ceil(temp, 4294967297)`, ErrOutOfRange},
+		{`pow(price, 1001)`, ErrOutOfRange},
+		{`pow(decimal('10'), 9223372036854775807)`, ErrOutOfRange},
+		{`pow(decimal('1e-600'), 2)`, ErrOutOfRange},
+	}
+	for _, tt := range errs {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), tt.err), tt.expr)
+	}
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// stringArg returns the text of a string argument, numbers and booleans are
+// formatted, undefined and null are not strings.
+func stringArg(node Node) (string, bool) {
+	switch node.Type() {
+	case Undefined, Null:
+		return "", false
+	case JSON:
+		if cc, ok := structuralNode(node).(JSONNode); ok && cc.datatype == Null {
+			return "", false
+		}
+	}
+	s, ok := node.To(String).(StringNode)
+	return string(s), ok
+}
+
+// intArg returns the value of an integer argument.
+func intArg(node Node) (int, bool) {
+	n, ok := node.To(Int).(IntNode)
+	return int(n), ok
+}
+
+// stringFunc adapts a function of a single string.
+func stringFunc(fn func(s string) string) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		s, ok := stringArg(args[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		return StringNode(fn(s))
+	}
+}
+
+// trimFunc adapts a trim function, the optional second argument is the cutset,
+// white space is trimmed without it.
+func trimFunc(space func(string) string, cut func(s, cutset string) string) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 1 && len(args) != 2 {
+			return UNDEFINED_RESULT
+		}
+		s, ok := stringArg(args[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		if len(args) == 1 {
+			return StringNode(space(s))
+		}
+		cutset, ok := stringArg(args[1])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		return StringNode(cut(s, cutset))
+	}
+}
+
+// predicateFunc adapts a predicate of two strings.
+func predicateFunc(fn func(s, sub string) bool) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 2 {
+			return UNDEFINED_RESULT
+		}
+		s, ok1 := stringArg(args[0])
+		sub, ok2 := stringArg(args[1])
+		if !ok1 || !ok2 {
+			return UNDEFINED_RESULT
+		}
+		return BoolNode(fn(s, sub))
+	}
+}
+
+var (
+	upperFunc      = stringFunc(strings.ToUpper)
+	lowerFunc      = stringFunc(strings.ToLower)
+	trimSpaceFunc  = trimFunc(strings.TrimSpace, strings.Trim)
+	ltrimFunc      = trimFunc(func(s string) string { return strings.TrimLeftFunc(s, unicode.IsSpace) }, strings.TrimLeft)
+	rtrimFunc      = trimFunc(func(s string) string { return strings.TrimRightFunc(s, unicode.IsSpace) }, strings.TrimRight)
+	startsWithFunc = predicateFunc(strings.HasPrefix)
+	endsWithFunc   = predicateFunc(strings.HasSuffix)
+	containsFunc   = predicateFunc(strings.Contains)
+	padLeftFunc    = padFunc(true)
+	padRightFunc   = padFunc(false)
+)
+
+// substrFunc substr(s, start[, length]) returns length characters of s from start,
+// start counts from 0, a negative start counts from the end.
+var substrFunc = func(args ...Node) Node {
+	if len(args) != 2 && len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	s, ok := stringArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	start, ok := intArg(args[1])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	runes := []rune(s)
+	if start < 0 {
+		start += len(runes)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end := len(runes)
+	if len(args) == 3 {
+		n, ok := intArg(args[2])
+		if !ok || n < 0 {
+			return UNDEFINED_RESULT
+		}
+		if n < end-start {
+			end = start + n
+		}
+	}
+	return StringNode(runes[start:end])
+}
+
+// lengthFunc length(x) returns the number of characters of a string, the number
+// of bytes of bytes, the number of elements of an array or of keys of an object.
+var lengthFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	if b, ok := args[0].(BytesNode); ok {
+		return IntNode(len(b))
+	}
+	if cc, ok := structuralNode(args[0]).(JSONNode); ok && (cc.datatype == Array || cc.datatype == Object) {
+		return IntNode(len(members(cc)))
+	}
+	s, ok := stringArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	return IntNode(utf8.RuneCountInString(s))
+}
+
+// concatFunc concat(x, ...) joins the text of its arguments.
+var concatFunc = func(args ...Node) Node {
+	var sb strings.Builder
+	for _, arg := range args {
+		s, ok := stringArg(arg)
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		sb.WriteString(s)
+	}
+	return StringNode(sb.String())
+}
+
+// splitFunc split(s, sep) returns the array of substrings of s separated by sep.
+var splitFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	s, ok1 := stringArg(args[0])
+	sep, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	return FromGo(strings.Split(s, sep))
+}
+
+// joinFunc join(array, sep) joins the text of the elements of array with sep.
+var joinFunc = func(args ...Node) Node {
+	if len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	elems, ok1 := arrayElements(args[0])
+	sep, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	parts := make([]string, len(elems))
+	for i, elem := range elems {
+		s, ok := stringArg(elem)
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		parts[i] = s
+	}
+	return StringNode(strings.Join(parts, sep))
+}
+
+// replaceFunc replace(s, old, new[, n]) replaces the first n occurrences of old,
+// all of them without n.
+var replaceFunc = func(args ...Node) Node {
+	if len(args) != 3 && len(args) != 4 {
+		return UNDEFINED_RESULT
+	}
+	s, ok1 := stringArg(args[0])
+	old, ok2 := stringArg(args[1])
+	replacement, ok3 := stringArg(args[2])
+	if !ok1 || !ok2 || !ok3 {
+		return UNDEFINED_RESULT
+	}
+	n := -1
+	if len(args) == 4 {
+		var ok bool
+		if n, ok = intArg(args[3]); !ok {
+			return UNDEFINED_RESULT
+		}
+	}
+	return StringNode(strings.Replace(s, old, replacement, n))
+}
+
+// maxPadWidth is the largest width of pad_left and pad_right.
+const maxPadWidth = 1 << 16
+
+// padFunc pad_left(s, width[, pad]) and pad_right(s, width[, pad]) pad s with
+// pad, a space by default, up to width characters, at most maxPadWidth.
+func padFunc(left bool) ContextFunc {
+	return func(args ...Node) Node {
+		if len(args) != 2 && len(args) != 3 {
+			return UNDEFINED_RESULT
+		}
+		s, ok := stringArg(args[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		width, ok := intArg(args[1])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		pad := " "
+		if len(args) == 3 {
+			if pad, ok = stringArg(args[2]); !ok || pad == "" {
+				return UNDEFINED_RESULT
+			}
+		}
+		if width > maxPadWidth {
+			return errorNode(fmt.Errorf("%w: width %d", ErrOutOfRange, width))
+		}
+		n := width - utf8.RuneCountInString(s)
+		if n <= 0 {
+			return StringNode(s)
+		}
+		padRunes := []rune(pad)
+		fill := make([]rune, n)
+		for i := range fill {
+			fill[i] = padRunes[i%len(padRunes)]
+		}
+		if left {
+			return StringNode(string(fill) + s)
+		}
+		return StringNode(s + string(fill))
+	}
+}
+
+// formatFunc format(layout, x, ...) formats its arguments as fmt.Sprintf, the
+// arguments are converted by ToGo. A missing or extra argument, a verb which does
+// not fit its argument, or a width or precision beyond maxPadWidth is an error.
+var formatFunc = func(args ...Node) Node {
+	if len(args) < 1 {
+		return UNDEFINED_RESULT
+	}
+	layout, ok := stringArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	var out strings.Builder
+	next := 1
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			out.WriteByte(layout[i])
+			continue
+		}
+		spec, bad := formatSpec(layout[i:])
+		if bad != nil {
+			return bad
+		}
+		i += len(spec) - 1
+		verb, _ := utf8.DecodeLastRuneInString(spec)
+		if verb == '%' {
+			out.WriteByte('%')
+			continue
+		}
+		if next >= len(args) {
+			return errorNode(fmt.Errorf("%w: missing argument of %s in format", ErrInvalidArgument, spec))
+		}
+		value := ToGo(args[next])
+		if d, ok := value.(json.Number); ok && strings.ContainsRune("eEfFgG", verb) {
+			// a decimal is formatted as a float by the float verbs.
+			value, _ = d.Float64()
+		}
+		text := fmt.Sprintf(spec, value)
+		if strings.Contains(text, "%!") && !strings.Contains(fmt.Sprint(value), "%!") {
+			return errorNode(fmt.Errorf("%w: %s of format does not fit %s", ErrInvalidArgument, spec, args[next].Type()))
+		}
+		out.WriteString(text)
+		next++
+	}
+	if next < len(args) {
+		return errorNode(fmt.Errorf("%w: %d extra arguments of format", ErrInvalidArgument, len(args)-next))
+	}
+	return StringNode(out.String())
+}
+
+// formatSpec returns the verb at the start of layout, e.g. "%-5.2f", its width and
+// precision are at most maxPadWidth. A width or an argument given by '*' or '[n]' is not supported.
+func formatSpec(layout string) (string, Node) {
+	i := 1
+	for i < len(layout) && strings.IndexByte("+-# 0", layout[i]) >= 0 {
+		i++
+	}
+	i, ok := formatDigits(layout, i)
+	if ok && i < len(layout) && layout[i] == '.' {
+		i, ok = formatDigits(layout, i+1)
+	}
+	switch {
+	case !ok:
+		return "", errorNode(fmt.Errorf("%w: width %s in format", ErrOutOfRange, layout[:i]))
+	case i >= len(layout):
+		return "", errorNode(fmt.Errorf("%w: %s has no verb in format", ErrInvalidArgument, layout))
+	case layout[i] == '*' || layout[i] == '[':
+		return "", errorNode(fmt.Errorf("%w: %s is not supported in format", ErrInvalidArgument, layout[:i+1]))
+	}
+	_, size := utf8.DecodeRuneInString(layout[i:])
+	return layout[:i+size], nil
+}
+
+// formatDigits skips the digits of layout from i, ok is false if their number exceeds maxPadWidth.
+func formatDigits(layout string, i int) (int, bool) {
+	n := 0
+	for ; i < len(layout) && layout[i] >= '0' && layout[i] <= '9'; i++ {
+		if n = n*10 + int(layout[i]-'0'); n > maxPadWidth {
+			return i + 1, false
+		}
+	}
+	return i, true
+}
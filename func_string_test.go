@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"name":  StringNode("Grüße, 世界"),
+		"tags":  New(`["a","b",3]`),
+		"count": IntNode(7),
+		"dev":   New(`{"id":"dev-01"}`),
+	}, nil)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`upper(name)`, "GRÜßE, 世界"},
+		{`lower('ÀB')`, "àb"},
+		{`trim('  a b  ')`, "a b"},
+		{`trim('xxaxx', 'x')`, "a"},
+		{`ltrim('  a ')`, "a "},
+		{`rtrim('  a ')`, "  a"},
+		{`rtrim('a--', '-')`, "a"},
+		{`substr(name, 6)`, " 世界"},
+		{`substr(name, 0, 5)`, "Grüße"},
+		{`substr(name, -2)`, "世界"},
+		{`substr(name, 20)`, ""},
+		{`length(name)`, "9"},
+		{`length(tags)`, "3"},
+		{`length(bytes('hex:0102'))`, "2"},
+		{`concat(dev.id, '/', count)`, "dev-01/7"},
+		{`concat(dev.id, missing)`, ""},
+		{`split('a,b,,c', ',')`, `["a","b","","c"]`},
+		{`join(tags, '-')`, "a-b-3"},
+		{`replace('aaa', 'a', 'b')`, "bbb"},
+		{`replace('aaa', 'a', 'b', 2)`, "bba"},
+		{`starts_with(name, 'Grü')`, "true"},
+		{`ends_with(name, '界')`, "true"},
+		{`contains(dev.id, 'v-0')`, "true"},
+		{`pad_left(count, 3, '0')`, "007"},
+		{`pad_right('世', 3, '·')`, "世··"},
+		{`pad_left(name, 2)`, "Grüße, 世界"},
+		{`format('%s=%d (%.1f)', dev.id, count, 2.25)`, "dev-01=7 (2.2)"},
+		{`format('%-4s|%05.1f%%', 'ab', decimal('2.25'))`, "ab  |002.2%"},
+		{`format('%s', '100%!')`, "100%!"},
+		{`format('%v %x', true, 'hi')`, "true 6869"},
+		{`upper()`, ""},
+		{`substr('abc', 1, 9223372036854775807)`, "bc"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+
+	for _, s := range []string{`pad_left('x', 9223372036854775807)`, `pad_right('x', 65537)`,
+		`format('%999999999d', 1)`, `format('%.99999999999999999999f', 1.5)`} {
+		expr, err := ParseExpr(s)
+		assert.NoError(t, err, s)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), ErrOutOfRange), s)
+	}
+
+	// the text of a fmt error is not a result.
+	for _, s := range []string{`format('%s %s', 1)`, `format('%s %s', 'a')`, `format('%d', 'a', 2)`,
+		`format('%d', 'a')`, `format('%t', 1)`, `format('%*d', 5, 1)`, `format('%[1]d', 1)`, `format('50%')`} {
+		expr, err := ParseExpr(s)
+		assert.NoError(t, err, s)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), ErrInvalidArgument), s)
+	}
+}
@@ -0,0 +1,475 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	errInvalidTimezone = errors.New("invalid timezone")
+	errInvalidTimeUnit = errors.New("invalid time unit")
+)
+
+// timeLayouts are the names accepted as a layout by format_time and parse_time,
+// any other layout is a Go reference layout.
+var timeLayouts = map[string]string{
+	"ansic":       time.ANSIC,
+	"rfc822":      time.RFC822,
+	"rfc1123":     time.RFC1123,
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"kitchen":     time.Kitchen,
+	"datetime":    "2006-01-02 15:04:05",
+	"date":        "2006-01-02",
+	"time":        "15:04:05",
+}
+
+var locations sync.Map
+
+// loadLocation returns the IANA timezone name, the loaded locations are cached.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" || strings.EqualFold(name, "local") {
+		// the timezone of the host is not a timezone of the rule.
+		return nil, fmt.Errorf("%w: %q", errInvalidTimezone, name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// timeArg returns the time of a timestamp argument, epochs and ISO-8601 text are converted.
+func timeArg(node Node) (time.Time, bool) {
+	ts, ok := node.To(Timestamp).(TimestampNode)
+	return ts.Time(), ok
+}
+
+// locationArg returns the timezone given by the optional argument at i,
+// the timezone of the rule without it.
+func locationArg(o *options, args []Node, i int) (*time.Location, Node) {
+	if len(args) <= i {
+		return o.location, nil
+	}
+	name, ok := stringArg(args[i])
+	if !ok {
+		return nil, UNDEFINED_RESULT
+	}
+	loc, err := loadLocation(name)
+	if err != nil {
+		return nil, errorNode(err)
+	}
+	return loc, nil
+}
+
+// timeUnit is a calendar unit of the time functions, e.g. "hour" or "months".
+type timeUnit int
+
+const (
+	unitNanosecond timeUnit = iota
+	unitMicrosecond
+	unitMillisecond
+	unitSecond
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitQuarter
+	unitYear
+)
+
+var timeUnits = map[string]timeUnit{
+	"nanosecond":  unitNanosecond,
+	"ns":          unitNanosecond,
+	"microsecond": unitMicrosecond,
+	"us":          unitMicrosecond,
+	"millisecond": unitMillisecond,
+	"ms":          unitMillisecond,
+	"second":      unitSecond,
+	"s":           unitSecond,
+	"minute":      unitMinute,
+	"hour":        unitHour,
+	"day":         unitDay,
+	"week":        unitWeek,
+	"month":       unitMonth,
+	"quarter":     unitQuarter,
+	"year":        unitYear,
+}
+
+// fixed returns the length of a unit below a day.
+func (u timeUnit) fixed() (time.Duration, bool) {
+	switch u {
+	case unitNanosecond:
+		return time.Nanosecond, true
+	case unitMicrosecond:
+		return time.Microsecond, true
+	case unitMillisecond:
+		return time.Millisecond, true
+	case unitSecond:
+		return time.Second, true
+	case unitMinute:
+		return time.Minute, true
+	case unitHour:
+		return time.Hour, true
+	}
+	return 0, false
+}
+
+// months returns the number of months of a unit above a week.
+func (u timeUnit) months() int {
+	switch u {
+	case unitMonth:
+		return 1
+	case unitQuarter:
+		return 3
+	case unitYear:
+		return 12
+	}
+	return 0
+}
+
+func unitArg(node Node) (timeUnit, Node) {
+	name, ok := stringArg(node)
+	if !ok {
+		return 0, UNDEFINED_RESULT
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if unit, ok := timeUnits[name]; ok {
+		return unit, nil
+	}
+	if unit, ok := timeUnits[strings.TrimSuffix(name, "s")]; ok {
+		return unit, nil
+	}
+	return 0, errorNode(fmt.Errorf("%w: %q", errInvalidTimeUnit, name))
+}
+
+// nowFunc now() returns the time of the clock of the rule, in the timezone of the rule.
+var nowFunc = func(o *options, args ...Node) Node {
+	if len(args) != 0 {
+		return UNDEFINED_RESULT
+	}
+	return TimestampNode(o.clock().In(o.location))
+}
+
+// fromUnixtimeFunc from_unixtime(epoch[, unit]) returns the timestamp of an epoch
+// in seconds, or in the unit given as 's', 'ms', 'us' or 'ns'.
+var fromUnixtimeFunc = func(args ...Node) Node {
+	if len(args) != 1 && len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	epoch, ok := floatArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	unit := unitSecond
+	if len(args) == 2 {
+		var bad Node
+		if unit, bad = unitArg(args[1]); bad != nil {
+			return bad
+		}
+	}
+	d, ok := unit.fixed()
+	if !ok || unit > unitSecond {
+		return errorNode(fmt.Errorf("%w: epoch in %s", errInvalidTimeUnit, args[1]))
+	}
+	ns := epoch * float64(d)
+	if math.IsNaN(ns) || ns >= math.MaxInt64 || ns <= math.MinInt64 {
+		return errorNode(ErrIntegerOverflow)
+	}
+	return TimestampNode(time.Unix(0, int64(ns)).UTC())
+}
+
+// toUnixtimeFunc to_unixtime(ts[, unit]) returns the epoch of ts in seconds,
+// or in the unit given as 's', 'ms', 'us' or 'ns'.
+var toUnixtimeFunc = func(args ...Node) Node {
+	if len(args) != 1 && len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	t, ok := timeArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	unit := unitSecond
+	if len(args) == 2 {
+		var bad Node
+		if unit, bad = unitArg(args[1]); bad != nil {
+			return bad
+		}
+	}
+	switch unit {
+	case unitSecond:
+		return IntNode(t.Unix())
+	case unitMillisecond:
+		return IntNode(t.UnixMilli())
+	case unitMicrosecond:
+		return IntNode(t.UnixMicro())
+	case unitNanosecond:
+		return IntNode(t.UnixNano())
+	}
+	return errorNode(fmt.Errorf("%w: epoch in %s", errInvalidTimeUnit, args[1]))
+}
+
+// formatTimeFunc format_time(ts, layout[, tz]) formats ts in the timezone,
+// layout is a Go reference layout or a name such as 'rfc3339' or 'datetime'.
+var formatTimeFunc = func(o *options, args ...Node) Node {
+	if len(args) != 2 && len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	t, ok1 := timeArg(args[0])
+	layout, ok2 := stringArg(args[1])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 2)
+	if bad != nil {
+		return bad
+	}
+	if named, ok := timeLayouts[strings.ToLower(layout)]; ok {
+		layout = named
+	}
+	return StringNode(t.In(loc).Format(layout))
+}
+
+// parseTimeFunc parse_time(s[, layout[, tz]]) parse s by layout, a text without
+// zone is read in the timezone. Without layout s is read as ISO-8601.
+var parseTimeFunc = func(o *options, args ...Node) Node {
+	if len(args) < 1 || len(args) > 3 {
+		return UNDEFINED_RESULT
+	}
+	s, ok := stringArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 2)
+	if bad != nil {
+		return bad
+	}
+	layouts := timestampLayouts
+	if len(args) >= 2 {
+		layout, ok := stringArg(args[1])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		if named, ok := timeLayouts[strings.ToLower(layout)]; ok {
+			layout = named
+		}
+		layouts = []string{layout}
+	}
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, strings.TrimSpace(s), loc); err == nil {
+			return TimestampNode(t)
+		}
+	}
+	return errorNode(fmt.Errorf("%w: %q", errInvalidTimestamp, s))
+}
+
+// truncTime returns t truncated to unit in the timezone of t, weeks start on Monday.
+func truncTime(t time.Time, unit timeUnit) time.Time {
+	if d, ok := unit.fixed(); ok && d < time.Hour {
+		return t.Truncate(d)
+	}
+	year, month, day := t.Date()
+	switch unit {
+	case unitHour:
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, t.Location())
+	case unitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case unitWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+	case unitMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case unitQuarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location())
+	}
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+}
+
+// dateTruncFunc date_trunc(unit, ts[, tz]) truncates ts to the start of unit in the timezone.
+var dateTruncFunc = func(o *options, args ...Node) Node {
+	if len(args) != 2 && len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	unit, bad := unitArg(args[0])
+	if bad != nil {
+		return bad
+	}
+	t, ok := timeArg(args[1])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 2)
+	if bad != nil {
+		return bad
+	}
+	return TimestampNode(truncTime(t.In(loc), unit))
+}
+
+// addTime returns t plus n units, days and larger units follow the calendar of the timezone of t.
+// As in SQL, the day is clamped to the last day of the month, e.g. Jan 31 plus a month is Feb 28.
+// ok is false if n units are out of range.
+func addTime(t time.Time, unit timeUnit, n int) (time.Time, bool) {
+	if d, ok := unit.fixed(); ok {
+		ns, ok := mulInt64(int64(n), int64(d))
+		return t.Add(time.Duration(ns)), ok
+	}
+	switch unit {
+	case unitDay:
+		return t.AddDate(0, 0, n), true
+	case unitWeek:
+		days, ok := mulInt64(int64(n), 7)
+		return t.AddDate(0, 0, int(days)), ok
+	}
+	months, ok := mulInt64(int64(n), int64(unit.months()))
+	if !ok {
+		return t, false
+	}
+	year, month, day := t.Date()
+	// the day 0 of the next month is the last day of the target month.
+	last := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month+time.Month(months), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()), true
+}
+
+// dateAddFunc date_add(unit, n, ts[, tz]) adds n units to ts, days and larger
+// units follow the calendar of the timezone across daylight saving changes.
+var dateAddFunc = func(o *options, args ...Node) Node {
+	if len(args) != 3 && len(args) != 4 {
+		return UNDEFINED_RESULT
+	}
+	unit, bad := unitArg(args[0])
+	if bad != nil {
+		return bad
+	}
+	n, ok1 := intArg(args[1])
+	t, ok2 := timeArg(args[2])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 3)
+	if bad != nil {
+		return bad
+	}
+	ret, ok := addTime(t.In(loc), unit, n)
+	if !ok {
+		return errorNode(fmt.Errorf("%w: %d %s", ErrOutOfRange, n, args[0]))
+	}
+	return TimestampNode(ret)
+}
+
+// dateDiffFunc date_diff(unit, from, to[, tz]) returns the number of whole units
+// from from to to, negative if to is before from.
+var dateDiffFunc = func(o *options, args ...Node) Node {
+	if len(args) != 3 && len(args) != 4 {
+		return UNDEFINED_RESULT
+	}
+	unit, bad := unitArg(args[0])
+	if bad != nil {
+		return bad
+	}
+	from, ok1 := timeArg(args[1])
+	to, ok2 := timeArg(args[2])
+	if !ok1 || !ok2 {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 3)
+	if bad != nil {
+		return bad
+	}
+	from, to = from.In(loc), to.In(loc)
+	if d, ok := unit.fixed(); ok {
+		return IntNode(to.Sub(from) / d)
+	}
+
+	n := calendarDays(from, to)
+	switch unit {
+	case unitWeek:
+		n /= 7
+	case unitMonth, unitQuarter, unitYear:
+		months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+		n = months / unit.months()
+	}
+	// the last unit is not whole if the day or the time of day is not reached.
+	// n is bounded by the dates, adding it is in range.
+	for n > 0 && addDiff(from, unit, n).After(to) {
+		n--
+	}
+	for n < 0 && addDiff(from, unit, n).Before(to) {
+		n++
+	}
+	return IntNode(n)
+}
+
+// addDiff returns from plus n units of date_diff, which are in range.
+func addDiff(from time.Time, unit timeUnit, n int) time.Time {
+	t, _ := addTime(from, unit, n)
+	return t
+}
+
+// calendarDays returns the number of calendar days from from to to.
+func calendarDays(from, to time.Time) int {
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	a := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	b := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a) / (24 * time.Hour))
+}
+
+// dayOfWeekFunc day_of_week(ts[, tz]) returns the ISO day of week of ts in the timezone,
+// 1 for Monday to 7 for Sunday.
+var dayOfWeekFunc = func(o *options, args ...Node) Node {
+	if len(args) != 1 && len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	t, ok := timeArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 1)
+	if bad != nil {
+		return bad
+	}
+	return IntNode((int(t.In(loc).Weekday())+6)%7 + 1)
+}
+
+// hourFunc hour(ts[, tz]) returns the hour of ts in the timezone.
+var hourFunc = func(o *options, args ...Node) Node {
+	if len(args) != 1 && len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	t, ok := timeArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	loc, bad := locationArg(o, args, 1)
+	if bad != nil {
+		return bad
+	}
+	return IntNode(t.In(loc).Hour())
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFunc(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	assert.NoError(t, err)
+	clock := func() time.Time { return time.Date(2021, 3, 28, 0, 30, 0, 0, time.UTC) }
+	ctx := ContextWithOptions(NewMapContext(map[string]Node{
+		"ts":    StringNode("2021-03-28T00:30:00Z"),
+		"epoch": IntNode(1616891400),
+	}, nil), WithClock(clock))
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`now()`, "2021-03-28T00:30:00Z"},
+		{`from_unixtime(epoch)`, "2021-03-28T00:30:00Z"},
+		{`from_unixtime(1616891400500, 'ms')`, "2021-03-28T00:30:00.5Z"},
+		{`to_unixtime(ts)`, "1616891400"},
+		{`to_unixtime(ts, 'ms')`, "1616891400000"},
+		{`format_time(ts, 'datetime')`, "2021-03-28 00:30:00"},
+		{`format_time(ts, 'datetime', 'Asia/Shanghai')`, "2021-03-28 08:30:00"},
+		{`format_time(ts, '15:04 MST', 'Europe/Berlin')`, "01:30 CET"},
+		{`parse_time('2021-03-28 08:30', '2006-01-02 15:04', 'Asia/Shanghai')`, "2021-03-28T08:30:00+08:00"},
+		{`parse_time('2021-03-28')`, "2021-03-28T00:00:00Z"},
+		{`date_trunc('hour', ts)`, "2021-03-28T00:00:00Z"},
+		{`date_trunc('day', ts, 'Asia/Shanghai')`, "2021-03-28T00:00:00+08:00"},
+		{`date_trunc('week', ts)`, "2021-03-22T00:00:00Z"},
+		{`date_trunc('quarter', ts)`, "2021-01-01T00:00:00Z"},
+		{`date_trunc('month', now())`, "2021-03-01T00:00:00Z"},
+		{`date_add('minutes', 90, ts)`, "2021-03-28T02:00:00Z"},
+		// the day is clamped to the last day of the month.
+		{`date_add('month', 1, '2021-01-31')`, "2021-02-28T00:00:00Z"},
+		{`date_add('month', 1, '2020-01-31T10:00:00Z')`, "2020-02-29T10:00:00Z"},
+		{`date_add('month', -1, '2021-03-31')`, "2021-02-28T00:00:00Z"},
+		{`date_add('quarter', 1, '2021-11-30')`, "2022-02-28T00:00:00Z"},
+		{`date_add('year', 1, '2020-02-29')`, "2021-02-28T00:00:00Z"},
+		{`date_add('year', 4, '2020-02-29')`, "2024-02-29T00:00:00Z"},
+		{`date_add('month', 1, '2021-01-15')`, "2021-02-15T00:00:00Z"},
+		// the day of the change to summer time has 23 hours in Berlin.
+		{`date_add('day', 1, '2021-03-27T12:00:00Z', 'Europe/Berlin')`, "2021-03-28T13:00:00+02:00"},
+		{`date_diff('hour', '2021-03-27T12:00:00Z', ts)`, "12"},
+		{`date_diff('day', '2021-03-27T12:00:00Z', ts)`, "0"},
+		{`date_diff('day', ts, '2021-03-20T00:00:00Z')`, "-8"},
+		{`date_diff('month', '2021-01-31', '2021-03-30')`, "1"},
+		{`date_diff('year', '2020-03-28', ts)`, "1"},
+		{`day_of_week(ts)`, "7"},
+		{`day_of_week('2021-03-28T23:30:00Z', 'Asia/Shanghai')`, "1"},
+		{`hour(ts)`, "0"},
+		{`hour(ts, 'Asia/Shanghai')`, "8"},
+		{`hour('x')`, ""},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+
+	expr, err := ParseExpr(`hour(ts, 'Mars/Olympus')`)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), errInvalidTimezone))
+
+	for _, raw := range []string{
+		`date_add('hour', 9223372036854775807, ts)`,
+		`date_add('week', 9223372036854775807, ts)`,
+		`date_add('year', 9223372036854775807, ts)`,
+	} {
+		expr, err := ParseExpr(raw)
+		assert.NoError(t, err)
+		assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), ErrOutOfRange), raw)
+	}
+
+	e, err := NewExpr(`hour(now())`, nil, WithClock(clock), WithLocation(shanghai))
+	assert.NoError(t, err)
+	assert.Equal(t, IntNode(8), e.Eval(nil))
+}
+
+func TestTimeFuncExec(t *testing.T) {
+	clock := func() time.Time { return time.Date(2021, 3, 28, 0, 30, 0, 0, time.UTC) }
+	tql, err := NewTDTL(`insert into target select date_diff('second', dev.ts, now()) as age`, nil, WithClock(clock))
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{"dev": New(`{"ts": 1616891340000}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, IntNode(60), ret["age"])
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+var nullJSON = []byte("null")
+
+// UnmarshalNode parse json into a node, scalars become BoolNode, IntNode,
+// FloatNode or StringNode and objects or arrays become a Collect.
+func UnmarshalNode(data []byte) (Node, error) {
+	data = bytes.TrimSpace(data)
+	if !json.Valid(data) {
+		return UNDEFINED_RESULT, fmt.Errorf("tdtl: invalid json %q", data)
+	}
+	cc := New(data)
+	node := cc.Node()
+	if cc.datatype == Number && node.Type() == Undefined {
+		// 1e3 is read as a float.
+		return StringNode(data).To(Float), nil
+	}
+	return node, nil
+}
+
+// UnmarshalNodes parse a json object, such as the marshaled result of Exec, into a map of nodes.
+func UnmarshalNodes(data []byte) (map[string]Node, error) {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]Node, len(raw))
+	for k, v := range raw {
+		node, err := UnmarshalNode(v)
+		if err != nil {
+			return nil, err
+		}
+		ret[k] = node
+	}
+	return ret, nil
+}
+
+// unmarshalString unmarshal a json string, it fails on any other json value.
+func unmarshalString(data []byte, typ Type) (string, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", fmt.Errorf("tdtl: cannot unmarshal %s into %s", data, typ)
+	}
+	return s, nil
+}
+
+func (r BoolNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+func (r *BoolNode) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err != nil {
+		return fmt.Errorf("tdtl: cannot unmarshal %s into %s", data, Bool)
+	}
+	*r = BoolNode(b)
+	return nil
+}
+
+func (r IntNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+func (r *IntNode) UnmarshalJSON(data []byte) error {
+	var i int64
+	if err := json.Unmarshal(data, &i); err != nil {
+		return fmt.Errorf("tdtl: cannot unmarshal %s into %s", data, Int)
+	}
+	*r = IntNode(i)
+	return nil
+}
+
+func (r FloatNode) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(r)) || math.IsInf(float64(r), 0) {
+		return nil, fmt.Errorf("tdtl: unsupported float value %s", r)
+	}
+	return r.Raw(), nil
+}
+
+func (r *FloatNode) UnmarshalJSON(data []byte) error {
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("tdtl: cannot unmarshal %s into %s", data, Float)
+	}
+	*r = FloatNode(f)
+	return nil
+}
+
+func (r StringNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+func (r *StringNode) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalString(data, String)
+	if err != nil {
+		return err
+	}
+	*r = StringNode(s)
+	return nil
+}
+
+// MarshalJSON returns the json of the collect, undefined is written as null.
+func (cc JSONNode) MarshalJSON() ([]byte, error) {
+	switch cc.datatype {
+	case Undefined, Null:
+		return nullJSON, nil
+	}
+	return cc.Raw(), nil
+}
+
+func (cc *JSONNode) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if !json.Valid(data) {
+		return fmt.Errorf("tdtl: invalid json %q", data)
+	}
+	*cc = *newCollect(data)
+	return cc.err
+}
+
+func (r DecimalNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+// UnmarshalJSON accepts a json number or a numeric string.
+func (r *DecimalNode) UnmarshalJSON(data []byte) error {
+	text := string(bytes.TrimSpace(data))
+	if s, err := unmarshalString(data, Decimal); err == nil {
+		text = s
+	}
+	d, err := NewDecimal(text)
+	if err != nil {
+		return err
+	}
+	*r = d
+	return nil
+}
+
+func (r TimestampNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+// UnmarshalJSON accepts an ISO-8601 string or an epoch number.
+func (r *TimestampNode) UnmarshalJSON(data []byte) error {
+	text := string(bytes.TrimSpace(data))
+	if s, err := unmarshalString(data, Timestamp); err == nil {
+		text = s
+	}
+	t, err := ParseTimestamp(text)
+	if err != nil {
+		return err
+	}
+	*r = t
+	return nil
+}
+
+func (r DurationNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+// UnmarshalJSON accepts a duration string or a number of milliseconds.
+func (r *DurationNode) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalString(data, Duration)
+	if err != nil {
+		var ms float64
+		if json.Unmarshal(data, &ms) != nil {
+			return err
+		}
+		*r = DurationNode(ms * float64(time.Millisecond))
+		return nil
+	}
+	d, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*r = d
+	return nil
+}
+
+func (r BytesNode) MarshalJSON() ([]byte, error) {
+	return r.Raw(), nil
+}
+
+// UnmarshalJSON accepts the hinted text of a BytesNode.
+func (r *BytesNode) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalString(data, Bytes)
+	if err != nil {
+		return err
+	}
+	b, ok := ParseBytes(s)
+	if !ok {
+		return fmt.Errorf("tdtl: cannot unmarshal %s into %s", data, Bytes)
+	}
+	*r = b
+	return nil
+}
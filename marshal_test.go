@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	ret := map[string]Node{
+		"bool":     BoolNode(true),
+		"int":      IntNode(1),
+		"float":    FloatNode(0.1),
+		"string":   StringNode("1"),
+		"quote":    StringNode(`a"b`),
+		"object":   New(`{"a":[1,"x"]}`),
+		"value":    New(`{"a":1}`).Node(),
+		"text":     New(`"a\nb"`),
+		"null":     NULL_RESULT,
+		"missing":  UNDEFINED_RESULT,
+		"decimal":  mustDecimal("1.10"),
+		"time":     EpochTimestamp(1524448722000),
+		"interval": DurationNode(time.Minute),
+		"bytes":    BytesNode{0xff},
+	}
+	data, err := json.Marshal(ret)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"bool": true, "int": 1, "float": 0.1, "string": "1", "quote": "a\"b",
+		"object": {"a":[1,"x"]}, "value": {"a":1}, "text": "a\nb",
+		"null": null, "missing": null, "decimal": 1.10,
+		"time": "2018-04-23T01:58:42Z", "interval": "1m0s", "bytes": "base64:/w=="
+	}`, string(data))
+
+	_, err = json.Marshal(FloatNode(math.NaN()))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var out struct {
+		Bool     BoolNode      `json:"bool"`
+		Int      IntNode       `json:"int"`
+		Float    FloatNode     `json:"float"`
+		String   StringNode    `json:"string"`
+		Object   JSONNode      `json:"object"`
+		Collect  *Collect      `json:"collect"`
+		Decimal  DecimalNode   `json:"decimal"`
+		Time     TimestampNode `json:"time"`
+		Interval DurationNode  `json:"interval"`
+		Bytes    BytesNode     `json:"bytes"`
+	}
+	err := json.Unmarshal([]byte(`{
+		"bool": true, "int": 1, "float": 0.5, "string": "a\"b",
+		"object": {"a": [1]}, "collect": "x\ty", "decimal": "1.10",
+		"time": 1524448722000, "interval": "PT1M", "bytes": "hex:ff"
+	}`), &out)
+	assert.NoError(t, err)
+	assert.Equal(t, BoolNode(true), out.Bool)
+	assert.Equal(t, IntNode(1), out.Int)
+	assert.Equal(t, FloatNode(0.5), out.Float)
+	assert.Equal(t, StringNode(`a"b`), out.String)
+	assert.Equal(t, Object, out.Object.Type())
+	assert.Equal(t, `{"a": [1]}`, out.Object.String())
+	assert.Equal(t, StringNode("x\ty"), out.Collect.Node())
+	assert.Equal(t, "1.10", out.Decimal.String())
+	assert.Equal(t, EpochTimestamp(1524448722000), out.Time)
+	assert.Equal(t, DurationNode(time.Minute), out.Interval)
+	assert.Equal(t, BytesNode{0xff}, out.Bytes)
+
+	var i IntNode
+	assert.Error(t, json.Unmarshal([]byte(`"1"`), &i))
+	var s StringNode
+	assert.Error(t, json.Unmarshal([]byte(`1`), &s))
+}
+
+func TestUnmarshalNodes(t *testing.T) {
+	want := map[string]Node{
+		"int":    IntNode(1),
+		"float":  FloatNode(1.5),
+		"exp":    FloatNode(1000),
+		"string": StringNode("1"),
+		"bool":   BoolNode(false),
+		"null":   NULL_RESULT,
+	}
+	data, err := json.Marshal(want)
+	assert.NoError(t, err)
+	data = append(data[:len(data)-1], []byte(`,"exp":1e3,"object":{"a":1}}`)...)
+
+	got, err := UnmarshalNodes(data)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, got["object"].String())
+	delete(got, "object")
+	assert.Equal(t, want, got)
+
+	_, err = UnmarshalNode([]byte(`{"a":`))
+	assert.Error(t, err)
+}
+
+func TestMarshalExec(t *testing.T) {
+	tqlInst, err := NewTDTL(`insert into target select entity1.property1 as p1, entity1.property1 + 1 as p2`, nil)
+	assert.NoError(t, err)
+	result, err := tqlInst.Exec(map[string]Node{
+		"entity1.property1": StringNode("123"),
+	})
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(result)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"p1":"123","p2":"1231"}`, string(data))
+
+	back, err := UnmarshalNodes(data)
+	assert.NoError(t, err)
+	assert.Equal(t, StringNode("123"), back["p1"])
+}
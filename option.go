@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import "time"
+
+// Option configures a TDTL rule or an Expression.
+type Option func(*options)
+
+type options struct {
+	// precision of float results, -1 means the shortest representation.
+	precision      int
+	fieldPrecision map[string]int
+	// fractional digits and rounding of a decimal division that does not terminate.
+	divisionScale int32
+	rounding      RoundingMode
+	mode          EvalMode
+	schemas       map[string]Schema
+	// clock of now() and the default timezone of the time functions.
+	clock    func() time.Time
+	location *time.Location
+	units    *UnitRegistry
+	// functions declared by the application, in addition to Builtins.
+	functions *FunctionRegistry
+	// locals are the functions imported or created by the rule.
+	locals *FunctionRegistry
+	// runtimeFunctions leaves the calls of undeclared functions to the context.
+	runtimeFunctions bool
+	// state of the stateful functions, kept per rule and per dimension of the input.
+	state      StateStore
+	rule       string
+	dimensions []*JSONPathExpr
+}
+
+// defaultOptions are the options of an evaluation outside a rule,
+// it has no state store so that no state is shared between evaluations.
+var defaultOptions = func() *options {
+	o := newOptions(nil)
+	o.state = nil
+	return o
+}()
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		precision:      -1,
+		fieldPrecision: map[string]int{},
+		divisionScale:  defaultDivisionScale,
+		rounding:       RoundHalfEven,
+		schemas:        map[string]Schema{},
+		clock:          time.Now,
+		location:       time.UTC,
+		units:          DefaultUnits,
+		state:          NewMemoryStateStore(DefaultStateSize, DefaultStateTTL),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithPrecision rounds every float result to n fractional digits, the result is
+// a decimal which keeps them, e.g. 50.1 is 50.100 with n = 3.
+func WithPrecision(n int) Option {
+	return func(o *options) {
+		o.precision = n
+	}
+}
+
+// WithFieldPrecision rounds the float result of field to n fractional digits,
+// it takes precedence over WithPrecision.
+func WithFieldPrecision(field string, n int) Option {
+	return func(o *options) {
+		o.fieldPrecision[field] = n
+	}
+}
+
+// WithDecimalDivision keeps scale fractional digits of a decimal division that does not
+// terminate, rounded by mode, 16 digits rounded half even by default.
+// The scale is limited to 0..MaxDecimalScale.
+func WithDecimalDivision(scale int32, mode RoundingMode) Option {
+	return func(o *options) {
+		switch {
+		case scale < 0:
+			scale = 0
+		case scale > MaxDecimalScale:
+			scale = MaxDecimalScale
+		}
+		o.divisionScale = scale
+		o.rounding = mode
+	}
+}
+
+// WithEvalMode selects how binary operators and the arguments of functions
+// treat values of different types, the default is LenientMode.
+func WithEvalMode(mode EvalMode) Option {
+	return func(o *options) {
+		o.mode = mode
+	}
+}
+
+// WithRuntimeFunctions lets a rule call functions which are neither built in,
+// declared nor in extFunc, the context resolves them at runtime. By default
+// NewTDTL fails on such a call.
+func WithRuntimeFunctions() Option {
+	return func(o *options) {
+		o.runtimeFunctions = true
+	}
+}
+
+// WithSchema declares the schema of entity, NewTDTL type checks the rule
+// against the declared schemas and fails with TypeErrors.
+func WithSchema(entity string, schema Schema) Option {
+	return func(o *options) {
+		o.schemas[entity] = schema
+	}
+}
+
+// WithClock sets the clock read by now(), time.Now by default.
+func WithClock(clock func() time.Time) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// WithLocation sets the timezone of the time functions called without one, UTC by default.
+func WithLocation(loc *time.Location) Option {
+	return func(o *options) {
+		o.location = loc
+	}
+}
+
+// WithUnits sets the unit registry of convert(), DefaultUnits by default.
+func WithUnits(units *UnitRegistry) Option {
+	return func(o *options) {
+		o.units = units
+	}
+}
+
+// WithFunctions makes the functions of r callable from the rule, the calls are
+// validated against their signatures like the calls of the built-in functions.
+func WithFunctions(r *FunctionRegistry) Option {
+	return func(o *options) {
+		o.functions = r
+	}
+}
+
+// WithStateStore keeps the state of the stateful functions such as delta(x) in store
+// under the name of the rule, a rule keeps it in memory by default, at most DefaultStateSize
+// values for DefaultStateTTL after their last update.
+func WithStateStore(store StateStore, rule string) Option {
+	return func(o *options) {
+		o.state = store
+		o.rule = rule
+	}
+}
+
+// WithDimensions keeps the state of the stateful functions per value of the json
+// paths of the input, e.g. WithDimensions("dev.id") for a rule of many devices.
+func WithDimensions(paths ...string) Option {
+	return func(o *options) {
+		o.dimensions = o.dimensions[:0]
+		for _, path := range paths {
+			o.dimensions = append(o.dimensions, &JSONPathExpr{val: path})
+		}
+	}
+}
+
+// ContextWithOptions returns a context which carries opts through the evaluation of ctx,
+// e.g. the clock of now() in EvalRuleQL.
+func ContextWithOptions(ctx Context, opts ...Option) Context {
+	return modeContext{ctx, newOptions(opts)}
+}
+
+func (o *options) precisionOf(field string) int {
+	if n, ok := o.fieldPrecision[field]; ok {
+		return n
+	}
+	return o.precision
+}
+
+// applyPrecision rounds float or decimal node to the precision configured for field.
+func (o *options) applyPrecision(field string, node Node) Node {
+	n := o.precisionOf(field)
+	if n < 0 {
+		return node
+	}
+	switch node.(type) {
+	case FloatNode, DecimalNode:
+		return roundNode(node, n)
+	}
+	return node
+}
+
+// optionFunc is a built-in function which depends on the options of the rule,
+// such as the clock or the timezone.
+type optionFunc func(o *options, args ...Node) Node
+
+// lookupFunction returns the declared function name, a built-in function first,
+// then a function imported or created by the rule.
+func (o *options) lookupFunction(name string) *Function {
+	if fn := Builtins.lookup(name); fn != nil {
+		return fn
+	}
+	if o == nil {
+		return nil
+	}
+	if fn := o.locals.lookup(name); fn != nil {
+		return fn
+	}
+	return o.functions.lookup(name)
+}
+
+// defaultOptionFunc binds fn to the default options, for a call outside a rule.
+func defaultOptionFunc(fn optionFunc) ContextFunc {
+	return func(args ...Node) Node {
+		return fn(defaultOptions, args...)
+	}
+}
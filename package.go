@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FunctionPackage is a versioned library of functions. A rule imports it by name
+// before INSERT, e.g. IMPORT acme@1.2 AS a, and calls its functions by the name
+// or the alias of the package, e.g. a.decode(raw).
+type FunctionPackage interface {
+	Name() string
+	Version() string
+	Functions() []Function
+}
+
+// NewFunctionPackage returns the package name at version of funcs.
+func NewFunctionPackage(name, version string, funcs ...Function) FunctionPackage {
+	return &functionPackage{name: name, version: version, funcs: funcs}
+}
+
+type functionPackage struct {
+	name    string
+	version string
+	funcs   []Function
+}
+
+func (p *functionPackage) Name() string          { return p.name }
+func (p *functionPackage) Version() string       { return p.version }
+func (p *functionPackage) Functions() []Function { return p.funcs }
+
+// importSpec is a package imported by a rule, IMPORT name[@version] [AS alias].
+type importSpec struct {
+	pos     Pos
+	name    string
+	version string
+	alias   string
+}
+
+// matchVersion reports whether version is the version want of an import,
+// or a release of it, e.g. 1.2.3 matches 1 and 1.2.
+func matchVersion(version, want string) bool {
+	return want == "" || version == want || strings.HasPrefix(version, want+".")
+}
+
+// compareVersions compares dotted versions part by part, numerically when both parts
+// are numbers, e.g. 1.10.0 is newer than 1.9.2. It returns -1, 0 or +1.
+func compareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.ParseUint(pa[i], 10, 64)
+		nb, errB := strconv.ParseUint(pb[i], 10, 64)
+		switch {
+		case errA != nil || errB != nil:
+			if ret := strings.Compare(pa[i], pb[i]); ret != 0 {
+				return ret
+			}
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+	return compareInt(int64(len(pa)), int64(len(pb)))
+}
+
+// importPackages resolves the imports of a rule in the registry of WithFunctions,
+// then in Builtins, to the newest version which matches, and declares their functions in the namespaces of their aliases.
+func (o *options) importPackages(imports []importSpec) error {
+	if len(imports) == 0 {
+		return nil
+	}
+	o.locals = NewFunctionRegistry()
+	aliases := map[string]bool{}
+	for _, spec := range imports {
+		if !identifier.MatchString(spec.alias) {
+			return fmt.Errorf("[%s]invalid alias %s of package %s", spec.pos, spec.alias, spec.name)
+		}
+		registry := o.functions
+		versions := registry.versions(spec.name)
+		if len(versions) == 0 {
+			registry, versions = Builtins, Builtins.versions(spec.name)
+		}
+		if len(versions) == 0 {
+			return fmt.Errorf("[%s]undefined package %s", spec.pos, spec.name)
+		}
+		pkg, ok := registry.Package(spec.name, spec.version)
+		if !ok {
+			return fmt.Errorf("[%s]package %s %s does not match version %s",
+				spec.pos, spec.name, strings.Join(versions, ", "), spec.version)
+		}
+		if aliases[spec.alias] {
+			return fmt.Errorf("[%s]package %s imported twice", spec.pos, spec.alias)
+		}
+		aliases[spec.alias] = true
+		for _, fn := range pkg.Functions() {
+			fn.Name = spec.alias + "." + fn.Name
+			if err := o.locals.Register(fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func acmePackage(version string) FunctionPackage {
+	return NewFunctionPackage("acme", version,
+		Function{Name: "convert", Params: []Param{{"s", stringTypes}}, Result: String,
+			Call: func(args ...Node) Node { return StringNode("acme:" + strings.ToUpper(args[0].String())) }},
+		Function{Name: "scale", Params: []Param{{"x", numberTypes}}, Result: Float,
+			Call: func(args ...Node) Node { f, _ := floatArg(args[0]); return FloatNode(f * 10) }},
+	)
+}
+
+func TestFunctionPackage(t *testing.T) {
+	r := NewFunctionRegistry()
+	assert.NoError(t, r.RegisterPackage(acmePackage("1.2.0")))
+	assert.NoError(t, r.Register(Function{Name: "other.convert", Params: []Param{{"s", stringTypes}}, Result: String,
+		Call: func(args ...Node) Node { return StringNode("other:" + args[0].String()) }}))
+	pkg, ok := r.Package("acme", "")
+	assert.True(t, ok)
+	assert.Equal(t, "1.2.0", pkg.Version())
+
+	tests := []struct {
+		name string
+		sql  string
+		want map[string]string
+	}{
+		{"import", `IMPORT acme INSERT INTO target SELECT acme.convert(dev.name) as name, acme.scale(dev.x) as x`,
+			map[string]string{"name": "acme:PUMP", "x": "25"}},
+		{"alias", "IMPORT acme@1.2 AS a\nINSERT INTO target SELECT a.convert(dev.name) as name, other.convert(dev.name) as other",
+			map[string]string{"name": "acme:PUMP", "other": "other:pump"}},
+		{"version", `import acme@1 as a, acme@1.2.0 insert into target select a.scale(1) + acme.scale(dev.x) as x`,
+			map[string]string{"x": "35"}},
+		{"path", `insert into target select dev.name as import`, map[string]string{"import": "pump"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tql, err := NewTDTL(tt.sql, nil, WithFunctions(r))
+			assert.NoError(t, err)
+			ret, err := tql.Exec(map[string]Node{"dev.name": StringNode("pump"), "dev.x": FloatNode(2.5)})
+			assert.NoError(t, err)
+			for k, v := range tt.want {
+				assert.Equal(t, v, ret[k].String(), k)
+			}
+		})
+	}
+}
+
+func TestFunctionPackageErrors(t *testing.T) {
+	r := NewFunctionRegistry()
+	assert.NoError(t, r.RegisterPackage(acmePackage("1.2.0")))
+	assert.Error(t, r.RegisterPackage(NewFunctionPackage("acme.v2", "2.0.0")))
+	assert.Error(t, r.RegisterPackage(NewFunctionPackage("acme", "")))
+	assert.Error(t, r.RegisterPackage(NewFunctionPackage("acme", "2.0.0",
+		Function{Name: "acme.convert", Call: upperFunc})))
+	assert.Error(t, r.Register(Function{Name: "acme..convert", Call: upperFunc}))
+
+	tests := []struct {
+		sql string
+		err string
+	}{
+		{`IMPORT units insert into target select dev.name as name`, "[1:7]undefined package units"},
+		{`IMPORT acme@1.3 insert into target select dev.name as name`, "[1:7]package acme 1.2.0 does not match version 1.3"},
+		{`IMPORT acme, acme insert into target select dev.name as name`, "[1:13]package acme imported twice"},
+		{`IMPORT acme, insert into target select dev.name as name`, "[1:13]missing {CREATE, DECIMAL, FUNCTION, IMPORT, INTERVAL, TIMESTAMP, INDENTIFIER, PATHITEM} at 'insert'"},
+		{`IMPORT a[0] insert into target select dev.name as name`, "[1:7]invalid package name a[0]"},
+		{`insert into target select a[0](dev.name) as name`, "[1:26]invalid function name a[0]"},
+	}
+	for _, tt := range tests {
+		_, err := NewTDTL(tt.sql, nil, WithFunctions(r))
+		assert.EqualError(t, err, tt.err, tt.sql)
+	}
+
+	// a package which is not imported, or imported under an alias, is not called.
+	for sql, pos := range map[string]string{
+		`insert into target select acme.convert(dev.name) as x`:                  "1:26",
+		`IMPORT acme AS a insert into target select acme.convert(dev.name) as x`: "1:43",
+	} {
+		_, err := NewTDTL(sql, nil, WithFunctions(r))
+		assert.EqualError(t, err, "["+pos+"]acme.convert(dev.name): undefined function acme.convert", sql)
+	}
+}
+
+func TestImportAsIdentifier(t *testing.T) {
+	r := NewFunctionRegistry()
+	assert.NoError(t, r.RegisterPackage(acmePackage("1.2.0")))
+
+	expr, err := ParseExpr(`import + 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", EvalRuleQL(NewJSONContext(`{"import": 2}`), expr).String())
+
+	// IMPORT is a keyword only at the start of a clause.
+	tql, err := NewTDTL(`IMPORT acme insert into target select import + 1 as x, acme.convert(import.name) as name`,
+		nil, WithFunctions(r))
+	assert.NoError(t, err)
+	assert.Contains(t, tql.Entities(), "import")
+	ret, err := tql.Exec(map[string]Node{"import": IntNode(2), "import.name": StringNode("pump")})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", ret["x"].String())
+	assert.Equal(t, "acme:PUMP", ret["name"].String())
+}
+
+func TestFunctionPackageVersions(t *testing.T) {
+	r := NewFunctionRegistry()
+	for _, version := range []string{"1.2.0", "1.10.1", "1.9.3", "2.0.0", "1.2.4"} {
+		version := version
+		assert.NoError(t, r.RegisterPackage(NewFunctionPackage("acme", version,
+			Function{Name: "version", Result: String, Call: func(args ...Node) Node { return StringNode(version) }})))
+	}
+
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"", "2.0.0"},
+		{"1", "1.10.1"},
+		{"1.2", "1.2.4"},
+		{"1.9", "1.9.3"},
+		{"1.2.0", "1.2.0"},
+		{"2", "2.0.0"},
+	}
+	for _, tt := range tests {
+		pkg, ok := r.Package("acme", tt.version)
+		assert.True(t, ok, tt.version)
+		assert.Equal(t, tt.want, pkg.Version(), tt.version)
+	}
+	_, ok := r.Package("acme", "3")
+	assert.False(t, ok)
+
+	tql, err := NewTDTL(`IMPORT acme@1 AS a, acme@1.2 AS b insert into target select a.version() as a, b.version() as b`,
+		nil, WithFunctions(r))
+	assert.NoError(t, err)
+	ret, err := tql.Exec(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.10.1", ret["a"].String())
+	assert.Equal(t, "1.2.4", ret["b"].String())
+
+	_, err = NewTDTL(`IMPORT acme@1.3 insert into target select dev.name as name`, nil, WithFunctions(r))
+	assert.EqualError(t, err, "[1:7]package acme 1.2.0, 1.2.4, 1.9.3, 1.10.1, 2.0.0 does not match version 1.3")
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.0", "1.2.0", 0},
+		{"1.10.0", "1.9.2", 1},
+		{"1.2", "1.2.0", -1},
+		{"2.0.0", "10.0.0", -1},
+		{"1.0.0-rc1", "1.0.0-rc2", -1},
+		{"18446744073709551615", "1", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, compareVersions(tt.a, tt.b), "%s %s", tt.a, tt.b)
+		assert.Equal(t, -tt.want, compareVersions(tt.b, tt.a), "%s %s", tt.b, tt.a)
+	}
+}
+
+func TestBuiltinsPackage(t *testing.T) {
+	assert.NoError(t, Builtins.RegisterPackage(NewFunctionPackage("testpkg", "0.1.0",
+		Function{Name: "upper", Params: []Param{{"s", stringTypes}}, Result: String, Call: upperFunc})))
+	expr, err := NewExpr(`IMPORT testpkg testpkg.upper(dev.name)`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, StringNode("PUMP"), expr.Eval(map[string]Node{"dev.name": StringNode("pump")}))
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -36,8 +37,12 @@ type TDTLListener struct {
 	fields  map[string]string
 	//fields:    listener.fields,
 
-	expr   Expr
-	errors []string
+	expr    Expr
+	imports []importSpec
+	creates []createSpec
+	// function is set in the body of a function, its paths are not sources of the rule.
+	function bool
+	errors   []string
 }
 
 func (l *TDTLListener) setTarget(target string) {
@@ -169,6 +174,70 @@ func (l *TDTLListener) ExitTargetAsElem(c *parser.TargetAsElemContext) {
 	}
 }
 
+// importName is the name of an imported package with its version, e.g. acme@1.2.
+var importName = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)(@[0-9A-Za-z.\-]+)?$`)
+
+func (l *TDTLListener) ExitImport_elem(c *parser.Import_elemContext) {
+	//fmt.Println("ExitImport_elem", c.GetText())
+	name := c.GetName()
+	if name.GetTokenIndex() < 0 {
+		// the name is missing, the syntax error is reported.
+		return
+	}
+	match := importName.FindStringSubmatch(name.GetText())
+	if match == nil {
+		l.appendErrorf("[%s]invalid package name %s", tokenPos(name), name.GetText())
+		return
+	}
+	spec := importSpec{pos: tokenPos(name), name: match[1], version: strings.TrimPrefix(match[2], "@"), alias: match[1]}
+	if alias := c.GetAlias(); alias != nil {
+		spec.alias = alias.GetText()
+	}
+	l.imports = append(l.imports, spec)
+}
+
+func (l *TDTLListener) EnterCreateFunction(c *parser.CreateFunctionContext) {
+	l.function = true
+}
+
+func (l *TDTLListener) ExitCreateFunction(c *parser.CreateFunctionContext) {
+	//fmt.Println("ExitCreateFunction", c.GetText())
+	l.function = false
+	name, body := c.GetName(), c.Expr()
+	if body == nil || body.GetStop() == nil {
+		// a syntax error is reported.
+		return
+	}
+	spec := createSpec{
+		pos:  tokenPos(name),
+		name: name.GetText(),
+		body: l.pop(),
+		text: name.GetInputStream().GetText(body.GetStart().GetStart(), body.GetStop().GetStop()),
+	}
+	if name.GetTokenIndex() >= 0 && !functionName.MatchString(spec.name) {
+		l.appendErrorf("[%s]invalid function name %s", spec.pos, spec.name)
+	}
+	if params, ok := c.Param_list().(*parser.Param_listContext); ok {
+		for _, child := range params.GetChildren() {
+			param, ok := child.(antlr.TerminalNode)
+			if _, bad := child.(antlr.ErrorNode); !ok || bad || param.GetText() == "," {
+				continue
+			}
+			if !identifier.MatchString(param.GetText()) {
+				l.appendErrorf("[%s]invalid parameter %s of function %s", tokenPos(param.GetSymbol()), param.GetText(), spec.name)
+			}
+			for _, prev := range spec.params {
+				if prev == param.GetText() {
+					l.appendErrorf("[%s]duplicate parameter %s of function %s", tokenPos(param.GetSymbol()), param.GetText(), spec.name)
+					break
+				}
+			}
+			spec.params = append(spec.params, param.GetText())
+		}
+	}
+	l.creates = append(l.creates, spec)
+}
+
 func (l *TDTLListener) ExitBinary(c *parser.BinaryContext) {
 	right, left := l.pop(), l.pop()
 	//fmt.Println("ExitBinary", c.GetText(), left, c.GetOp().GetText(), right)
@@ -176,6 +245,22 @@ func (l *TDTLListener) ExitBinary(c *parser.BinaryContext) {
 		Op:  c.GetOp().GetTokenType(),
 		LHS: left,
 		RHS: right,
+		pos: tokenPos(c.GetOp()),
+	})
+}
+
+func (l *TDTLListener) ExitIn(c *parser.InContext) {
+	//fmt.Println("ExitIn", c.GetText())
+	n := len(c.AllExpr()) - 1
+	list := make([]Expr, n)
+	for i := n - 1; i >= 0; i-- {
+		list[i] = l.pop()
+	}
+	l.push(&InExpr{
+		exp:  l.pop(),
+		list: list,
+		not:  c.NOT() != nil,
+		pos:  tokenPos(c.IN().GetSymbol()),
 	})
 }
 
@@ -189,6 +274,23 @@ func (l *TDTLListener) ExitString(c *parser.StringContext) {
 	}
 }
 
+// typedLiterals maps the keyword of a typed literal to its type, e.g. DECIMAL '0.1'.
+var typedLiterals = map[int]Type{
+	parser.TDTLLexerDECIMAL:   Decimal,
+	parser.TDTLLexerINTERVAL:  Duration,
+	parser.TDTLLexerTIMESTAMP: Timestamp,
+}
+
+func (l *TDTLListener) ExitTypedLiteral(c *parser.TypedLiteralContext) {
+	//fmt.Println("ExitTypedLiteral", c.GetText())
+	str, typ := c.STRING().GetText(), typedLiterals[c.GetKind().GetTokenType()]
+	node := StringNode(str[1 : len(str)-1]).To(typ)
+	if node.Type() != typ {
+		l.appendErrorf("[%s]invalid %s literal %s", tokenPos(c.GetStart()), c.GetKind().GetText(), str)
+	}
+	l.push(node.(Expr))
+}
+
 func (l *TDTLListener) ExitInteger(c *parser.IntegerContext) {
 	//fmt.Println("ExitInteger", c.GetText())
 	i, err := strconv.ParseInt(c.GetText(), 10, 64)
@@ -228,10 +330,11 @@ func (l *TDTLListener) ExitXpath_name(c *parser.Xpath_nameContext) {
 		return
 	}
 	l.push(&JSONPathExpr{
-		expr,
+		val: expr,
+		pos: tokenPos(c.GetStart()),
 	})
 	xpaths := strings.Split(expr, ".")
-	if expr != "" && len(xpaths) > 0 {
+	if expr != "" && len(xpaths) > 0 && !l.function {
 		l.addSource(xpaths[0], expr)
 	}
 	//error
@@ -248,10 +351,14 @@ func (l *TDTLListener) ExitCall_expr(c *parser.Call_exprContext) {
 	for i := n - 1; i >= 0; i-- {
 		list = append(list, temp[i])
 	}
+	if key := c.GetKey(); !functionName.MatchString(key.GetText()) {
+		l.appendErrorf("[%s]invalid function name %s", tokenPos(key), key.GetText())
+	}
 	l.push(&CallExpr{
 		raw:  c.GetText(),
 		key:  c.GetKey().GetText(),
 		args: list,
+		pos:  tokenPos(c.GetStart()),
 	})
 }
 
@@ -260,6 +367,7 @@ func (l *TDTLListener) ExitSwitch_stmt(c *parser.Switch_stmtContext) {
 	n := len(c.AllExpr())
 	expr := &SwitchExpr{
 		list: make([]*CaseExpr, 0, n/2-1),
+		pos:  tokenPos(c.GetStart()),
 	}
 	if n < 2 {
 		return
@@ -362,6 +470,11 @@ func (l *TDTLListener) ReportContextSensitivity(recognizer antlr.Parser, dfa *an
 	//fmt.Println("ReportContextSensitivity", recognizer, dfa, startIndex, stopIndex, prediction, configs)
 }
 
+// tokenPos returns the position of token as reported by syntax errors.
+func tokenPos(token antlr.Token) Pos {
+	return Pos{Line: token.GetLine(), Column: token.GetColumn()}
+}
+
 func (l *TDTLListener) error() error {
 	if len(l.errors) == 0 {
 		return nil
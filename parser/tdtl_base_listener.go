@@ -27,6 +27,42 @@ func (s *BaseTDTLListener) EnterRoot(ctx *RootContext) {}
 // ExitRoot is called when production root is exited.
 func (s *BaseTDTLListener) ExitRoot(ctx *RootContext) {}
 
+// EnterScript is called when production script is entered.
+func (s *BaseTDTLListener) EnterScript(ctx *ScriptContext) {}
+
+// ExitScript is called when production script is exited.
+func (s *BaseTDTLListener) ExitScript(ctx *ScriptContext) {}
+
+// EnterExpression is called when production expression is entered.
+func (s *BaseTDTLListener) EnterExpression(ctx *ExpressionContext) {}
+
+// ExitExpression is called when production expression is exited.
+func (s *BaseTDTLListener) ExitExpression(ctx *ExpressionContext) {}
+
+// EnterImport is called when production Import is entered.
+func (s *BaseTDTLListener) EnterImport(ctx *ImportContext) {}
+
+// ExitImport is called when production Import is exited.
+func (s *BaseTDTLListener) ExitImport(ctx *ImportContext) {}
+
+// EnterCreateFunction is called when production CreateFunction is entered.
+func (s *BaseTDTLListener) EnterCreateFunction(ctx *CreateFunctionContext) {}
+
+// ExitCreateFunction is called when production CreateFunction is exited.
+func (s *BaseTDTLListener) ExitCreateFunction(ctx *CreateFunctionContext) {}
+
+// EnterImport_elem is called when production import_elem is entered.
+func (s *BaseTDTLListener) EnterImport_elem(ctx *Import_elemContext) {}
+
+// ExitImport_elem is called when production import_elem is exited.
+func (s *BaseTDTLListener) ExitImport_elem(ctx *Import_elemContext) {}
+
+// EnterParam_list is called when production param_list is entered.
+func (s *BaseTDTLListener) EnterParam_list(ctx *Param_listContext) {}
+
+// ExitParam_list is called when production param_list is exited.
+func (s *BaseTDTLListener) ExitParam_list(ctx *Param_listContext) {}
+
 // EnterTarget is called when production target is entered.
 func (s *BaseTDTLListener) EnterTarget(ctx *TargetContext) {}
 
@@ -105,6 +141,12 @@ func (s *BaseTDTLListener) EnterSwitch(ctx *SwitchContext) {}
 // ExitSwitch is called when production Switch is exited.
 func (s *BaseTDTLListener) ExitSwitch(ctx *SwitchContext) {}
 
+// EnterIn is called when production In is entered.
+func (s *BaseTDTLListener) EnterIn(ctx *InContext) {}
+
+// ExitIn is called when production In is exited.
+func (s *BaseTDTLListener) ExitIn(ctx *InContext) {}
+
 // EnterBinary is called when production Binary is entered.
 func (s *BaseTDTLListener) EnterBinary(ctx *BinaryContext) {}
 
@@ -147,6 +189,12 @@ func (s *BaseTDTLListener) EnterString(ctx *StringContext) {}
 // ExitString is called when production String is exited.
 func (s *BaseTDTLListener) ExitString(ctx *StringContext) {}
 
+// EnterTypedLiteral is called when production TypedLiteral is entered.
+func (s *BaseTDTLListener) EnterTypedLiteral(ctx *TypedLiteralContext) {}
+
+// ExitTypedLiteral is called when production TypedLiteral is exited.
+func (s *BaseTDTLListener) ExitTypedLiteral(ctx *TypedLiteralContext) {}
+
 // EnterSource is called when production Source is entered.
 func (s *BaseTDTLListener) EnterSource(ctx *SourceContext) {}
 
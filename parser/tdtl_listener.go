@@ -11,6 +11,24 @@ type TDTLListener interface {
 	// EnterRoot is called when entering the root production.
 	EnterRoot(c *RootContext)
 
+	// EnterScript is called when entering the script production.
+	EnterScript(c *ScriptContext)
+
+	// EnterExpression is called when entering the expression production.
+	EnterExpression(c *ExpressionContext)
+
+	// EnterImport is called when entering the Import production.
+	EnterImport(c *ImportContext)
+
+	// EnterCreateFunction is called when entering the CreateFunction production.
+	EnterCreateFunction(c *CreateFunctionContext)
+
+	// EnterImport_elem is called when entering the import_elem production.
+	EnterImport_elem(c *Import_elemContext)
+
+	// EnterParam_list is called when entering the param_list production.
+	EnterParam_list(c *Param_listContext)
+
 	// EnterTarget is called when entering the target production.
 	EnterTarget(c *TargetContext)
 
@@ -50,6 +68,9 @@ type TDTLListener interface {
 	// EnterSwitch is called when entering the Switch production.
 	EnterSwitch(c *SwitchContext)
 
+	// EnterIn is called when entering the In production.
+	EnterIn(c *InContext)
+
 	// EnterBinary is called when entering the Binary production.
 	EnterBinary(c *BinaryContext)
 
@@ -71,6 +92,9 @@ type TDTLListener interface {
 	// EnterString is called when entering the String production.
 	EnterString(c *StringContext)
 
+	// EnterTypedLiteral is called when entering the TypedLiteral production.
+	EnterTypedLiteral(c *TypedLiteralContext)
+
 	// EnterSource is called when entering the Source production.
 	EnterSource(c *SourceContext)
 
@@ -101,6 +125,24 @@ type TDTLListener interface {
 	// ExitRoot is called when exiting the root production.
 	ExitRoot(c *RootContext)
 
+	// ExitScript is called when exiting the script production.
+	ExitScript(c *ScriptContext)
+
+	// ExitExpression is called when exiting the expression production.
+	ExitExpression(c *ExpressionContext)
+
+	// ExitImport is called when exiting the Import production.
+	ExitImport(c *ImportContext)
+
+	// ExitCreateFunction is called when exiting the CreateFunction production.
+	ExitCreateFunction(c *CreateFunctionContext)
+
+	// ExitImport_elem is called when exiting the import_elem production.
+	ExitImport_elem(c *Import_elemContext)
+
+	// ExitParam_list is called when exiting the param_list production.
+	ExitParam_list(c *Param_listContext)
+
 	// ExitTarget is called when exiting the target production.
 	ExitTarget(c *TargetContext)
 
@@ -140,6 +182,9 @@ type TDTLListener interface {
 	// ExitSwitch is called when exiting the Switch production.
 	ExitSwitch(c *SwitchContext)
 
+	// ExitIn is called when exiting the In production.
+	ExitIn(c *InContext)
+
 	// ExitBinary is called when exiting the Binary production.
 	ExitBinary(c *BinaryContext)
 
@@ -161,6 +206,9 @@ type TDTLListener interface {
 	// ExitString is called when exiting the String production.
 	ExitString(c *StringContext)
 
+	// ExitTypedLiteral is called when exiting the TypedLiteral production.
+	ExitTypedLiteral(c *TypedLiteralContext)
+
 	// ExitSource is called when exiting the Source production.
 	ExitSource(c *SourceContext)
 
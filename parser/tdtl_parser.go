@@ -16,109 +16,149 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 49, 232,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 56, 316,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
-	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 7, 4, 57, 10,
-	4, 12, 4, 14, 4, 60, 11, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5, 68,
-	10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 7, 8, 79,
-	10, 8, 12, 8, 14, 8, 82, 11, 8, 3, 9, 3, 9, 3, 9, 7, 9, 87, 10, 9, 12,
-	9, 14, 9, 90, 11, 9, 3, 10, 5, 10, 93, 10, 10, 3, 10, 3, 10, 3, 11, 3,
-	11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 105, 10, 11, 3, 11,
-	3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 7, 11, 116, 10,
-	11, 12, 11, 14, 11, 119, 11, 11, 3, 12, 3, 12, 3, 13, 3, 13, 6, 13, 125,
-	10, 13, 13, 13, 14, 13, 126, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
-	3, 14, 5, 14, 136, 10, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 7, 15, 149, 10, 15, 12, 15, 14, 15, 152,
-	11, 15, 3, 15, 3, 15, 5, 15, 156, 10, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3,
-	16, 7, 16, 163, 10, 16, 12, 16, 14, 16, 166, 11, 16, 5, 16, 168, 10, 16,
-	3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 6, 18, 176, 10, 18, 13, 18, 14,
-	18, 177, 3, 18, 6, 18, 181, 10, 18, 13, 18, 14, 18, 182, 3, 18, 3, 18,
-	5, 18, 187, 10, 18, 3, 19, 3, 19, 6, 19, 191, 10, 19, 13, 19, 14, 19, 192,
-	3, 19, 6, 19, 196, 10, 19, 13, 19, 14, 19, 197, 3, 19, 3, 19, 5, 19, 202,
-	10, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21,
-	3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 219, 10, 21, 3, 22, 3,
-	22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 230, 10, 22,
-	3, 22, 2, 3, 20, 23, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
-	30, 32, 34, 36, 38, 40, 42, 2, 6, 3, 2, 33, 35, 3, 2, 36, 37, 4, 2, 19,
-	19, 21, 25, 4, 2, 41, 41, 46, 46, 2, 246, 2, 44, 3, 2, 2, 2, 4, 51, 3,
-	2, 2, 2, 6, 53, 3, 2, 2, 2, 8, 67, 3, 2, 2, 2, 10, 69, 3, 2, 2, 2, 12,
-	73, 3, 2, 2, 2, 14, 75, 3, 2, 2, 2, 16, 83, 3, 2, 2, 2, 18, 92, 3, 2, 2,
-	2, 20, 104, 3, 2, 2, 2, 22, 120, 3, 2, 2, 2, 24, 124, 3, 2, 2, 2, 26, 135,
-	3, 2, 2, 2, 28, 137, 3, 2, 2, 2, 30, 157, 3, 2, 2, 2, 32, 171, 3, 2, 2,
-	2, 34, 186, 3, 2, 2, 2, 36, 201, 3, 2, 2, 2, 38, 203, 3, 2, 2, 2, 40, 218,
-	3, 2, 2, 2, 42, 229, 3, 2, 2, 2, 44, 45, 7, 12, 2, 2, 45, 46, 7, 13, 2,
-	2, 46, 47, 5, 4, 3, 2, 47, 48, 7, 29, 2, 2, 48, 49, 5, 6, 4, 2, 49, 50,
-	7, 2, 2, 3, 50, 3, 3, 2, 2, 2, 51, 52, 7, 41, 2, 2, 52, 5, 3, 2, 2, 2,
-	53, 58, 5, 8, 5, 2, 54, 55, 7, 3, 2, 2, 55, 57, 5, 8, 5, 2, 56, 54, 3,
-	2, 2, 2, 57, 60, 3, 2, 2, 2, 58, 56, 3, 2, 2, 2, 58, 59, 3, 2, 2, 2, 59,
-	7, 3, 2, 2, 2, 60, 58, 3, 2, 2, 2, 61, 68, 5, 10, 6, 2, 62, 63, 5, 22,
-	12, 2, 63, 64, 7, 38, 2, 2, 64, 65, 5, 32, 17, 2, 65, 68, 3, 2, 2, 2, 66,
-	68, 5, 20, 11, 2, 67, 61, 3, 2, 2, 2, 67, 62, 3, 2, 2, 2, 67, 66, 3, 2,
-	2, 2, 68, 9, 3, 2, 2, 2, 69, 70, 5, 20, 11, 2, 70, 71, 7, 14, 2, 2, 71,
-	72, 5, 36, 19, 2, 72, 11, 3, 2, 2, 2, 73, 74, 5, 14, 8, 2, 74, 13, 3, 2,
-	2, 2, 75, 80, 5, 16, 9, 2, 76, 77, 7, 15, 2, 2, 77, 79, 5, 16, 9, 2, 78,
-	76, 3, 2, 2, 2, 79, 82, 3, 2, 2, 2, 80, 78, 3, 2, 2, 2, 80, 81, 3, 2, 2,
-	2, 81, 15, 3, 2, 2, 2, 82, 80, 3, 2, 2, 2, 83, 88, 5, 18, 10, 2, 84, 85,
-	7, 28, 2, 2, 85, 87, 5, 18, 10, 2, 86, 84, 3, 2, 2, 2, 87, 90, 3, 2, 2,
-	2, 88, 86, 3, 2, 2, 2, 88, 89, 3, 2, 2, 2, 89, 17, 3, 2, 2, 2, 90, 88,
-	3, 2, 2, 2, 91, 93, 7, 26, 2, 2, 92, 91, 3, 2, 2, 2, 92, 93, 3, 2, 2, 2,
-	93, 94, 3, 2, 2, 2, 94, 95, 5, 20, 11, 2, 95, 19, 3, 2, 2, 2, 96, 97, 8,
-	11, 1, 2, 97, 105, 5, 26, 14, 2, 98, 99, 7, 4, 2, 2, 99, 100, 5, 20, 11,
-	2, 100, 101, 7, 5, 2, 2, 101, 105, 3, 2, 2, 2, 102, 105, 5, 30, 16, 2,
-	103, 105, 5, 28, 15, 2, 104, 96, 3, 2, 2, 2, 104, 98, 3, 2, 2, 2, 104,
-	102, 3, 2, 2, 2, 104, 103, 3, 2, 2, 2, 105, 117, 3, 2, 2, 2, 106, 107,
-	12, 7, 2, 2, 107, 108, 9, 2, 2, 2, 108, 116, 5, 20, 11, 8, 109, 110, 12,
-	6, 2, 2, 110, 111, 9, 3, 2, 2, 111, 116, 5, 20, 11, 7, 112, 113, 12, 5,
-	2, 2, 113, 114, 9, 4, 2, 2, 114, 116, 5, 20, 11, 6, 115, 106, 3, 2, 2,
-	2, 115, 109, 3, 2, 2, 2, 115, 112, 3, 2, 2, 2, 116, 119, 3, 2, 2, 2, 117,
-	115, 3, 2, 2, 2, 117, 118, 3, 2, 2, 2, 118, 21, 3, 2, 2, 2, 119, 117, 3,
-	2, 2, 2, 120, 121, 7, 41, 2, 2, 121, 23, 3, 2, 2, 2, 122, 123, 7, 38, 2,
-	2, 123, 125, 7, 41, 2, 2, 124, 122, 3, 2, 2, 2, 125, 126, 3, 2, 2, 2, 126,
-	124, 3, 2, 2, 2, 126, 127, 3, 2, 2, 2, 127, 25, 3, 2, 2, 2, 128, 136, 7,
-	39, 2, 2, 129, 136, 7, 40, 2, 2, 130, 136, 7, 42, 2, 2, 131, 136, 7, 43,
-	2, 2, 132, 136, 7, 44, 2, 2, 133, 136, 7, 48, 2, 2, 134, 136, 5, 34, 18,
-	2, 135, 128, 3, 2, 2, 2, 135, 129, 3, 2, 2, 2, 135, 130, 3, 2, 2, 2, 135,
-	131, 3, 2, 2, 2, 135, 132, 3, 2, 2, 2, 135, 133, 3, 2, 2, 2, 135, 134,
-	3, 2, 2, 2, 136, 27, 3, 2, 2, 2, 137, 138, 7, 16, 2, 2, 138, 139, 5, 20,
-	11, 2, 139, 140, 7, 32, 2, 2, 140, 141, 5, 20, 11, 2, 141, 142, 7, 30,
-	2, 2, 142, 150, 5, 20, 11, 2, 143, 144, 7, 32, 2, 2, 144, 145, 5, 20, 11,
-	2, 145, 146, 7, 30, 2, 2, 146, 147, 5, 20, 11, 2, 147, 149, 3, 2, 2, 2,
-	148, 143, 3, 2, 2, 2, 149, 152, 3, 2, 2, 2, 150, 148, 3, 2, 2, 2, 150,
-	151, 3, 2, 2, 2, 151, 155, 3, 2, 2, 2, 152, 150, 3, 2, 2, 2, 153, 154,
-	7, 17, 2, 2, 154, 156, 5, 20, 11, 2, 155, 153, 3, 2, 2, 2, 155, 156, 3,
-	2, 2, 2, 156, 29, 3, 2, 2, 2, 157, 158, 7, 41, 2, 2, 158, 167, 7, 4, 2,
-	2, 159, 164, 5, 20, 11, 2, 160, 161, 7, 3, 2, 2, 161, 163, 5, 20, 11, 2,
-	162, 160, 3, 2, 2, 2, 163, 166, 3, 2, 2, 2, 164, 162, 3, 2, 2, 2, 164,
-	165, 3, 2, 2, 2, 165, 168, 3, 2, 2, 2, 166, 164, 3, 2, 2, 2, 167, 159,
-	3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 169, 3, 2, 2, 2, 169, 170, 7, 5,
-	2, 2, 170, 31, 3, 2, 2, 2, 171, 172, 7, 33, 2, 2, 172, 33, 3, 2, 2, 2,
-	173, 187, 5, 38, 20, 2, 174, 176, 7, 6, 2, 2, 175, 174, 3, 2, 2, 2, 176,
-	177, 3, 2, 2, 2, 177, 175, 3, 2, 2, 2, 177, 178, 3, 2, 2, 2, 178, 180,
-	3, 2, 2, 2, 179, 181, 5, 38, 20, 2, 180, 179, 3, 2, 2, 2, 181, 182, 3,
-	2, 2, 2, 182, 180, 3, 2, 2, 2, 182, 183, 3, 2, 2, 2, 183, 184, 3, 2, 2,
-	2, 184, 185, 7, 6, 2, 2, 185, 187, 3, 2, 2, 2, 186, 173, 3, 2, 2, 2, 186,
-	175, 3, 2, 2, 2, 187, 35, 3, 2, 2, 2, 188, 202, 5, 38, 20, 2, 189, 191,
-	7, 6, 2, 2, 190, 189, 3, 2, 2, 2, 191, 192, 3, 2, 2, 2, 192, 190, 3, 2,
-	2, 2, 192, 193, 3, 2, 2, 2, 193, 195, 3, 2, 2, 2, 194, 196, 5, 38, 20,
-	2, 195, 194, 3, 2, 2, 2, 196, 197, 3, 2, 2, 2, 197, 195, 3, 2, 2, 2, 197,
-	198, 3, 2, 2, 2, 198, 199, 3, 2, 2, 2, 199, 200, 7, 6, 2, 2, 200, 202,
-	3, 2, 2, 2, 201, 188, 3, 2, 2, 2, 201, 190, 3, 2, 2, 2, 202, 37, 3, 2,
-	2, 2, 203, 204, 9, 5, 2, 2, 204, 39, 3, 2, 2, 2, 205, 206, 7, 46, 2, 2,
-	206, 207, 7, 7, 2, 2, 207, 219, 7, 8, 2, 2, 208, 209, 7, 46, 2, 2, 209,
-	210, 7, 7, 2, 2, 210, 211, 7, 42, 2, 2, 211, 219, 7, 8, 2, 2, 212, 213,
-	7, 46, 2, 2, 213, 214, 7, 7, 2, 2, 214, 215, 7, 9, 2, 2, 215, 219, 7, 8,
-	2, 2, 216, 219, 7, 46, 2, 2, 217, 219, 7, 44, 2, 2, 218, 205, 3, 2, 2,
-	2, 218, 208, 3, 2, 2, 2, 218, 212, 3, 2, 2, 2, 218, 216, 3, 2, 2, 2, 218,
-	217, 3, 2, 2, 2, 219, 41, 3, 2, 2, 2, 220, 221, 7, 41, 2, 2, 221, 230,
-	7, 10, 2, 2, 222, 223, 7, 41, 2, 2, 223, 224, 7, 7, 2, 2, 224, 225, 7,
-	42, 2, 2, 225, 230, 7, 8, 2, 2, 226, 227, 7, 41, 2, 2, 227, 230, 7, 11,
-	2, 2, 228, 230, 7, 41, 2, 2, 229, 220, 3, 2, 2, 2, 229, 222, 3, 2, 2, 2,
-	229, 226, 3, 2, 2, 2, 229, 228, 3, 2, 2, 2, 230, 43, 3, 2, 2, 2, 24, 58,
-	67, 80, 88, 92, 104, 115, 117, 126, 135, 150, 155, 164, 167, 177, 182,
-	186, 192, 197, 201, 218, 229,
+	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 3, 2, 7, 2, 56,
+	10, 2, 12, 2, 14, 2, 59, 11, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 3, 7, 3, 69, 10, 3, 12, 3, 14, 3, 72, 11, 3, 3, 3, 3, 3, 3, 4, 7,
+	4, 77, 10, 4, 12, 4, 14, 4, 80, 11, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3,
+	5, 7, 5, 88, 10, 5, 12, 5, 14, 5, 91, 11, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3,
+	5, 5, 5, 98, 10, 5, 3, 5, 3, 5, 3, 5, 5, 5, 103, 10, 5, 3, 6, 3, 6, 3,
+	6, 5, 6, 108, 10, 6, 3, 7, 3, 7, 3, 7, 7, 7, 113, 10, 7, 12, 7, 14, 7,
+	116, 11, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 7, 9, 123, 10, 9, 12, 9, 14,
+	9, 126, 11, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 134, 10,
+	10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 7, 13,
+	145, 10, 13, 12, 13, 14, 13, 148, 11, 13, 3, 14, 3, 14, 3, 14, 7, 14, 153,
+	10, 14, 12, 14, 14, 14, 156, 11, 14, 3, 15, 5, 15, 159, 10, 15, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 171,
+	10, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
+	3, 16, 3, 16, 5, 16, 184, 10, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 7,
+	16, 191, 10, 16, 12, 16, 14, 16, 194, 11, 16, 3, 16, 3, 16, 7, 16, 198,
+	10, 16, 12, 16, 14, 16, 201, 11, 16, 3, 17, 3, 17, 3, 18, 3, 18, 6, 18,
+	207, 10, 18, 13, 18, 14, 18, 208, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 3, 19, 5, 19, 220, 10, 19, 3, 20, 3, 20, 3, 20, 3, 20,
+	3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 7, 20, 233, 10, 20, 12,
+	20, 14, 20, 236, 11, 20, 3, 20, 3, 20, 5, 20, 240, 10, 20, 3, 21, 3, 21,
+	3, 21, 3, 21, 3, 21, 7, 21, 247, 10, 21, 12, 21, 14, 21, 250, 11, 21, 5,
+	21, 252, 10, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 6, 23, 260,
+	10, 23, 13, 23, 14, 23, 261, 3, 23, 6, 23, 265, 10, 23, 13, 23, 14, 23,
+	266, 3, 23, 3, 23, 5, 23, 271, 10, 23, 3, 24, 3, 24, 6, 24, 275, 10, 24,
+	13, 24, 14, 24, 276, 3, 24, 6, 24, 280, 10, 24, 13, 24, 14, 24, 281, 3,
+	24, 3, 24, 5, 24, 286, 10, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26,
+	3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 303,
+	10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27,
+	5, 27, 314, 10, 27, 3, 27, 2, 3, 30, 28, 2, 4, 6, 8, 10, 12, 14, 16, 18,
+	20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 2,
+	8, 9, 2, 17, 18, 23, 23, 26, 26, 28, 28, 37, 37, 48, 48, 53, 53, 8, 2,
+	17, 18, 23, 23, 26, 26, 28, 28, 37, 37, 48, 48, 3, 2, 40, 42, 3, 2, 43,
+	44, 5, 2, 21, 21, 24, 25, 29, 31, 5, 2, 18, 18, 28, 28, 37, 37, 2, 337,
+	2, 57, 3, 2, 2, 2, 4, 70, 3, 2, 2, 2, 6, 78, 3, 2, 2, 2, 8, 102, 3, 2,
+	2, 2, 10, 104, 3, 2, 2, 2, 12, 109, 3, 2, 2, 2, 14, 117, 3, 2, 2, 2, 16,
+	119, 3, 2, 2, 2, 18, 133, 3, 2, 2, 2, 20, 135, 3, 2, 2, 2, 22, 139, 3,
+	2, 2, 2, 24, 141, 3, 2, 2, 2, 26, 149, 3, 2, 2, 2, 28, 158, 3, 2, 2, 2,
+	30, 170, 3, 2, 2, 2, 32, 202, 3, 2, 2, 2, 34, 206, 3, 2, 2, 2, 36, 219,
+	3, 2, 2, 2, 38, 221, 3, 2, 2, 2, 40, 241, 3, 2, 2, 2, 42, 255, 3, 2, 2,
+	2, 44, 270, 3, 2, 2, 2, 46, 285, 3, 2, 2, 2, 48, 287, 3, 2, 2, 2, 50, 302,
+	3, 2, 2, 2, 52, 313, 3, 2, 2, 2, 54, 56, 5, 8, 5, 2, 55, 54, 3, 2, 2, 2,
+	56, 59, 3, 2, 2, 2, 57, 55, 3, 2, 2, 2, 57, 58, 3, 2, 2, 2, 58, 60, 3,
+	2, 2, 2, 59, 57, 3, 2, 2, 2, 60, 61, 7, 12, 2, 2, 61, 62, 7, 13, 2, 2,
+	62, 63, 5, 14, 8, 2, 63, 64, 7, 35, 2, 2, 64, 65, 5, 16, 9, 2, 65, 66,
+	7, 2, 2, 3, 66, 3, 3, 2, 2, 2, 67, 69, 5, 8, 5, 2, 68, 67, 3, 2, 2, 2,
+	69, 72, 3, 2, 2, 2, 70, 68, 3, 2, 2, 2, 70, 71, 3, 2, 2, 2, 71, 73, 3,
+	2, 2, 2, 72, 70, 3, 2, 2, 2, 73, 74, 7, 2, 2, 3, 74, 5, 3, 2, 2, 2, 75,
+	77, 5, 8, 5, 2, 76, 75, 3, 2, 2, 2, 77, 80, 3, 2, 2, 2, 78, 76, 3, 2, 2,
+	2, 78, 79, 3, 2, 2, 2, 79, 81, 3, 2, 2, 2, 80, 78, 3, 2, 2, 2, 81, 82,
+	5, 18, 10, 2, 82, 7, 3, 2, 2, 2, 83, 84, 7, 26, 2, 2, 84, 89, 5, 10, 6,
+	2, 85, 86, 7, 3, 2, 2, 86, 88, 5, 10, 6, 2, 87, 85, 3, 2, 2, 2, 88, 91,
+	3, 2, 2, 2, 89, 87, 3, 2, 2, 2, 89, 90, 3, 2, 2, 2, 90, 103, 3, 2, 2, 2,
+	91, 89, 3, 2, 2, 2, 92, 93, 7, 17, 2, 2, 93, 94, 7, 23, 2, 2, 94, 95, 9,
+	2, 2, 2, 95, 97, 7, 4, 2, 2, 96, 98, 5, 12, 7, 2, 97, 96, 3, 2, 2, 2, 97,
+	98, 3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 100, 7, 5, 2, 2, 100, 101, 7, 14,
+	2, 2, 101, 103, 5, 30, 16, 2, 102, 83, 3, 2, 2, 2, 102, 92, 3, 2, 2, 2,
+	103, 9, 3, 2, 2, 2, 104, 107, 9, 2, 2, 2, 105, 106, 7, 14, 2, 2, 106, 108,
+	9, 3, 2, 2, 107, 105, 3, 2, 2, 2, 107, 108, 3, 2, 2, 2, 108, 11, 3, 2,
+	2, 2, 109, 114, 9, 3, 2, 2, 110, 111, 7, 3, 2, 2, 111, 113, 9, 3, 2, 2,
+	112, 110, 3, 2, 2, 2, 113, 116, 3, 2, 2, 2, 114, 112, 3, 2, 2, 2, 114,
+	115, 3, 2, 2, 2, 115, 13, 3, 2, 2, 2, 116, 114, 3, 2, 2, 2, 117, 118, 9,
+	3, 2, 2, 118, 15, 3, 2, 2, 2, 119, 124, 5, 18, 10, 2, 120, 121, 7, 3, 2,
+	2, 121, 123, 5, 18, 10, 2, 122, 120, 3, 2, 2, 2, 123, 126, 3, 2, 2, 2,
+	124, 122, 3, 2, 2, 2, 124, 125, 3, 2, 2, 2, 125, 17, 3, 2, 2, 2, 126, 124,
+	3, 2, 2, 2, 127, 134, 5, 20, 11, 2, 128, 129, 5, 32, 17, 2, 129, 130, 7,
+	45, 2, 2, 130, 131, 5, 42, 22, 2, 131, 134, 3, 2, 2, 2, 132, 134, 5, 30,
+	16, 2, 133, 127, 3, 2, 2, 2, 133, 128, 3, 2, 2, 2, 133, 132, 3, 2, 2, 2,
+	134, 19, 3, 2, 2, 2, 135, 136, 5, 30, 16, 2, 136, 137, 7, 14, 2, 2, 137,
+	138, 5, 46, 24, 2, 138, 21, 3, 2, 2, 2, 139, 140, 5, 24, 13, 2, 140, 23,
+	3, 2, 2, 2, 141, 146, 5, 26, 14, 2, 142, 143, 7, 15, 2, 2, 143, 145, 5,
+	26, 14, 2, 144, 142, 3, 2, 2, 2, 145, 148, 3, 2, 2, 2, 146, 144, 3, 2,
+	2, 2, 146, 147, 3, 2, 2, 2, 147, 25, 3, 2, 2, 2, 148, 146, 3, 2, 2, 2,
+	149, 154, 5, 28, 15, 2, 150, 151, 7, 34, 2, 2, 151, 153, 5, 28, 15, 2,
+	152, 150, 3, 2, 2, 2, 153, 156, 3, 2, 2, 2, 154, 152, 3, 2, 2, 2, 154,
+	155, 3, 2, 2, 2, 155, 27, 3, 2, 2, 2, 156, 154, 3, 2, 2, 2, 157, 159, 7,
+	32, 2, 2, 158, 157, 3, 2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 160, 3, 2, 2,
+	2, 160, 161, 5, 30, 16, 2, 161, 29, 3, 2, 2, 2, 162, 163, 8, 16, 1, 2,
+	163, 171, 5, 36, 19, 2, 164, 165, 7, 4, 2, 2, 165, 166, 5, 30, 16, 2, 166,
+	167, 7, 5, 2, 2, 167, 171, 3, 2, 2, 2, 168, 171, 5, 40, 21, 2, 169, 171,
+	5, 38, 20, 2, 170, 162, 3, 2, 2, 2, 170, 164, 3, 2, 2, 2, 170, 168, 3,
+	2, 2, 2, 170, 169, 3, 2, 2, 2, 171, 199, 3, 2, 2, 2, 172, 173, 12, 8, 2,
+	2, 173, 174, 9, 4, 2, 2, 174, 198, 5, 30, 16, 9, 175, 176, 12, 7, 2, 2,
+	176, 177, 9, 5, 2, 2, 177, 198, 5, 30, 16, 8, 178, 179, 12, 6, 2, 2, 179,
+	180, 9, 6, 2, 2, 180, 198, 5, 30, 16, 7, 181, 183, 12, 5, 2, 2, 182, 184,
+	7, 32, 2, 2, 183, 182, 3, 2, 2, 2, 183, 184, 3, 2, 2, 2, 184, 185, 3, 2,
+	2, 2, 185, 186, 7, 27, 2, 2, 186, 187, 7, 4, 2, 2, 187, 192, 5, 30, 16,
+	2, 188, 189, 7, 3, 2, 2, 189, 191, 5, 30, 16, 2, 190, 188, 3, 2, 2, 2,
+	191, 194, 3, 2, 2, 2, 192, 190, 3, 2, 2, 2, 192, 193, 3, 2, 2, 2, 193,
+	195, 3, 2, 2, 2, 194, 192, 3, 2, 2, 2, 195, 196, 7, 5, 2, 2, 196, 198,
+	3, 2, 2, 2, 197, 172, 3, 2, 2, 2, 197, 175, 3, 2, 2, 2, 197, 178, 3, 2,
+	2, 2, 197, 181, 3, 2, 2, 2, 198, 201, 3, 2, 2, 2, 199, 197, 3, 2, 2, 2,
+	199, 200, 3, 2, 2, 2, 200, 31, 3, 2, 2, 2, 201, 199, 3, 2, 2, 2, 202, 203,
+	9, 3, 2, 2, 203, 33, 3, 2, 2, 2, 204, 205, 7, 45, 2, 2, 205, 207, 7, 48,
+	2, 2, 206, 204, 3, 2, 2, 2, 207, 208, 3, 2, 2, 2, 208, 206, 3, 2, 2, 2,
+	208, 209, 3, 2, 2, 2, 209, 35, 3, 2, 2, 2, 210, 220, 7, 46, 2, 2, 211,
+	220, 7, 47, 2, 2, 212, 220, 7, 49, 2, 2, 213, 220, 7, 50, 2, 2, 214, 220,
+	7, 51, 2, 2, 215, 220, 7, 55, 2, 2, 216, 217, 9, 7, 2, 2, 217, 220, 7,
+	55, 2, 2, 218, 220, 5, 44, 23, 2, 219, 210, 3, 2, 2, 2, 219, 211, 3, 2,
+	2, 2, 219, 212, 3, 2, 2, 2, 219, 213, 3, 2, 2, 2, 219, 214, 3, 2, 2, 2,
+	219, 215, 3, 2, 2, 2, 219, 216, 3, 2, 2, 2, 219, 218, 3, 2, 2, 2, 220,
+	37, 3, 2, 2, 2, 221, 222, 7, 16, 2, 2, 222, 223, 5, 30, 16, 2, 223, 224,
+	7, 39, 2, 2, 224, 225, 5, 30, 16, 2, 225, 226, 7, 36, 2, 2, 226, 234, 5,
+	30, 16, 2, 227, 228, 7, 39, 2, 2, 228, 229, 5, 30, 16, 2, 229, 230, 7,
+	36, 2, 2, 230, 231, 5, 30, 16, 2, 231, 233, 3, 2, 2, 2, 232, 227, 3, 2,
+	2, 2, 233, 236, 3, 2, 2, 2, 234, 232, 3, 2, 2, 2, 234, 235, 3, 2, 2, 2,
+	235, 239, 3, 2, 2, 2, 236, 234, 3, 2, 2, 2, 237, 238, 7, 19, 2, 2, 238,
+	240, 5, 30, 16, 2, 239, 237, 3, 2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 39,
+	3, 2, 2, 2, 241, 242, 9, 2, 2, 2, 242, 251, 7, 4, 2, 2, 243, 248, 5, 30,
+	16, 2, 244, 245, 7, 3, 2, 2, 245, 247, 5, 30, 16, 2, 246, 244, 3, 2, 2,
+	2, 247, 250, 3, 2, 2, 2, 248, 246, 3, 2, 2, 2, 248, 249, 3, 2, 2, 2, 249,
+	252, 3, 2, 2, 2, 250, 248, 3, 2, 2, 2, 251, 243, 3, 2, 2, 2, 251, 252,
+	3, 2, 2, 2, 252, 253, 3, 2, 2, 2, 253, 254, 7, 5, 2, 2, 254, 41, 3, 2,
+	2, 2, 255, 256, 7, 40, 2, 2, 256, 43, 3, 2, 2, 2, 257, 271, 5, 48, 25,
+	2, 258, 260, 7, 6, 2, 2, 259, 258, 3, 2, 2, 2, 260, 261, 3, 2, 2, 2, 261,
+	259, 3, 2, 2, 2, 261, 262, 3, 2, 2, 2, 262, 264, 3, 2, 2, 2, 263, 265,
+	5, 48, 25, 2, 264, 263, 3, 2, 2, 2, 265, 266, 3, 2, 2, 2, 266, 264, 3,
+	2, 2, 2, 266, 267, 3, 2, 2, 2, 267, 268, 3, 2, 2, 2, 268, 269, 7, 6, 2,
+	2, 269, 271, 3, 2, 2, 2, 270, 257, 3, 2, 2, 2, 270, 259, 3, 2, 2, 2, 271,
+	45, 3, 2, 2, 2, 272, 286, 5, 48, 25, 2, 273, 275, 7, 6, 2, 2, 274, 273,
+	3, 2, 2, 2, 275, 276, 3, 2, 2, 2, 276, 274, 3, 2, 2, 2, 276, 277, 3, 2,
+	2, 2, 277, 279, 3, 2, 2, 2, 278, 280, 5, 48, 25, 2, 279, 278, 3, 2, 2,
+	2, 280, 281, 3, 2, 2, 2, 281, 279, 3, 2, 2, 2, 281, 282, 3, 2, 2, 2, 282,
+	283, 3, 2, 2, 2, 283, 284, 7, 6, 2, 2, 284, 286, 3, 2, 2, 2, 285, 272,
+	3, 2, 2, 2, 285, 274, 3, 2, 2, 2, 286, 47, 3, 2, 2, 2, 287, 288, 9, 2,
+	2, 2, 288, 49, 3, 2, 2, 2, 289, 290, 7, 53, 2, 2, 290, 291, 7, 7, 2, 2,
+	291, 303, 7, 8, 2, 2, 292, 293, 7, 53, 2, 2, 293, 294, 7, 7, 2, 2, 294,
+	295, 7, 49, 2, 2, 295, 303, 7, 8, 2, 2, 296, 297, 7, 53, 2, 2, 297, 298,
+	7, 7, 2, 2, 298, 299, 7, 9, 2, 2, 299, 303, 7, 8, 2, 2, 300, 303, 7, 53,
+	2, 2, 301, 303, 7, 51, 2, 2, 302, 289, 3, 2, 2, 2, 302, 292, 3, 2, 2, 2,
+	302, 296, 3, 2, 2, 2, 302, 300, 3, 2, 2, 2, 302, 301, 3, 2, 2, 2, 303,
+	51, 3, 2, 2, 2, 304, 305, 7, 48, 2, 2, 305, 314, 7, 10, 2, 2, 306, 307,
+	7, 48, 2, 2, 307, 308, 7, 7, 2, 2, 308, 309, 7, 49, 2, 2, 309, 314, 7,
+	8, 2, 2, 310, 311, 7, 48, 2, 2, 311, 314, 7, 11, 2, 2, 312, 314, 7, 48,
+	2, 2, 313, 304, 3, 2, 2, 2, 313, 306, 3, 2, 2, 2, 313, 310, 3, 2, 2, 2,
+	313, 312, 3, 2, 2, 2, 314, 53, 3, 2, 2, 2, 34, 57, 70, 78, 89, 97, 102,
+	107, 114, 124, 133, 146, 154, 158, 170, 183, 192, 197, 199, 208, 219, 234,
+	239, 248, 251, 261, 266, 270, 276, 281, 285, 302, 313,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -126,22 +166,24 @@ var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 var literalNames = []string{
 	"", "','", "'('", "')'", "'\"'", "'['", "']'", "'#'", "'[]'", "'[#]'",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "'*'", "'/'", "'%'", "'+'", "'-'", "'.'",
+	"", "", "", "", "", "", "", "", "", "", "'*'", "'/'", "'%'", "'+'", "'-'",
+	"'.'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "INSERT", "INTO", "AS", "AND",
-	"CASE", "ELSE", "END", "EQ", "FROM", "GT", "GTE", "LT", "LTE", "NE", "NOT",
-	"NULL", "OR", "SELECT", "THEN", "WHERE", "WHEN", "MUL", "DIV", "MOD", "ADD",
+	"CASE", "CREATE", "DECIMAL", "ELSE", "END", "EQ", "FROM", "FUNCTION", "GT",
+	"GTE", "IMPORT", "IN", "INTERVAL", "LT", "LTE", "NE", "NOT", "NULL", "OR",
+	"SELECT", "THEN", "TIMESTAMP", "WHERE", "WHEN", "MUL", "DIV", "MOD", "ADD",
 	"SUB", "DOT", "TRUE", "FALSE", "INDENTIFIER", "NUMBER", "INTEGER", "FLOAT",
 	"TOPICITEM", "PATHITEM", "ARRAYITEM", "STRING", "WHITESPACE",
 }
 
 var ruleNames = []string{
-	"root", "target", "fields", "field_elem", "field_elem_with_as", "filter",
-	"filter_condition", "filter_condition_or", "filter_condition_not", "expr",
-	"sourceEntity", "propertyEntity", "constant", "switch_stmt", "call_expr",
-	"asterisk", "xpath_name", "target_name", "dotnotation", "identifierWithTOPICITEM",
-	"identifierWithQualifier",
+	"root", "script", "expression", "clause", "import_elem", "param_list",
+	"target", "fields", "field_elem", "field_elem_with_as", "filter", "filter_condition",
+	"filter_condition_or", "filter_condition_not", "expr", "sourceEntity",
+	"propertyEntity", "constant", "switch_stmt", "call_expr", "asterisk", "xpath_name",
+	"target_name", "dotnotation", "identifierWithTOPICITEM", "identifierWithQualifier",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -186,64 +228,76 @@ const (
 	TDTLParserAS          = 12
 	TDTLParserAND         = 13
 	TDTLParserCASE        = 14
-	TDTLParserELSE        = 15
-	TDTLParserEND         = 16
-	TDTLParserEQ          = 17
-	TDTLParserFROM        = 18
-	TDTLParserGT          = 19
-	TDTLParserGTE         = 20
-	TDTLParserLT          = 21
-	TDTLParserLTE         = 22
-	TDTLParserNE          = 23
-	TDTLParserNOT         = 24
-	TDTLParserNULL        = 25
-	TDTLParserOR          = 26
-	TDTLParserSELECT      = 27
-	TDTLParserTHEN        = 28
-	TDTLParserWHERE       = 29
-	TDTLParserWHEN        = 30
-	TDTLParserMUL         = 31
-	TDTLParserDIV         = 32
-	TDTLParserMOD         = 33
-	TDTLParserADD         = 34
-	TDTLParserSUB         = 35
-	TDTLParserDOT         = 36
-	TDTLParserTRUE        = 37
-	TDTLParserFALSE       = 38
-	TDTLParserINDENTIFIER = 39
-	TDTLParserNUMBER      = 40
-	TDTLParserINTEGER     = 41
-	TDTLParserFLOAT       = 42
-	TDTLParserTOPICITEM   = 43
-	TDTLParserPATHITEM    = 44
-	TDTLParserARRAYITEM   = 45
-	TDTLParserSTRING      = 46
-	TDTLParserWHITESPACE  = 47
+	TDTLParserCREATE      = 15
+	TDTLParserDECIMAL     = 16
+	TDTLParserELSE        = 17
+	TDTLParserEND         = 18
+	TDTLParserEQ          = 19
+	TDTLParserFROM        = 20
+	TDTLParserFUNCTION    = 21
+	TDTLParserGT          = 22
+	TDTLParserGTE         = 23
+	TDTLParserIMPORT      = 24
+	TDTLParserIN          = 25
+	TDTLParserINTERVAL    = 26
+	TDTLParserLT          = 27
+	TDTLParserLTE         = 28
+	TDTLParserNE          = 29
+	TDTLParserNOT         = 30
+	TDTLParserNULL        = 31
+	TDTLParserOR          = 32
+	TDTLParserSELECT      = 33
+	TDTLParserTHEN        = 34
+	TDTLParserTIMESTAMP   = 35
+	TDTLParserWHERE       = 36
+	TDTLParserWHEN        = 37
+	TDTLParserMUL         = 38
+	TDTLParserDIV         = 39
+	TDTLParserMOD         = 40
+	TDTLParserADD         = 41
+	TDTLParserSUB         = 42
+	TDTLParserDOT         = 43
+	TDTLParserTRUE        = 44
+	TDTLParserFALSE       = 45
+	TDTLParserINDENTIFIER = 46
+	TDTLParserNUMBER      = 47
+	TDTLParserINTEGER     = 48
+	TDTLParserFLOAT       = 49
+	TDTLParserTOPICITEM   = 50
+	TDTLParserPATHITEM    = 51
+	TDTLParserARRAYITEM   = 52
+	TDTLParserSTRING      = 53
+	TDTLParserWHITESPACE  = 54
 )
 
 // TDTLParser rules.
 const (
 	TDTLParserRULE_root                    = 0
-	TDTLParserRULE_target                  = 1
-	TDTLParserRULE_fields                  = 2
-	TDTLParserRULE_field_elem              = 3
-	TDTLParserRULE_field_elem_with_as      = 4
-	TDTLParserRULE_filter                  = 5
-	TDTLParserRULE_filter_condition        = 6
-	TDTLParserRULE_filter_condition_or     = 7
-	TDTLParserRULE_filter_condition_not    = 8
-	TDTLParserRULE_expr                    = 9
-	TDTLParserRULE_sourceEntity            = 10
-	TDTLParserRULE_propertyEntity          = 11
-	TDTLParserRULE_constant                = 12
-	TDTLParserRULE_switch_stmt             = 13
-	TDTLParserRULE_call_expr               = 14
-	TDTLParserRULE_asterisk                = 15
-	TDTLParserRULE_xpath_name              = 16
-	TDTLParserRULE_target_name             = 17
-	TDTLParserRULE_dotnotation             = 18
-	TDTLParserRULE_identifierWithTOPICITEM = 19
-	TDTLParserRULE_identifierWithQualifier = 20
+	TDTLParserRULE_script                  = 1
+	TDTLParserRULE_expression              = 2
+	TDTLParserRULE_clause                  = 3
+	TDTLParserRULE_import_elem             = 4
+	TDTLParserRULE_param_list              = 5
+	TDTLParserRULE_target                  = 6
+	TDTLParserRULE_fields                  = 7
+	TDTLParserRULE_field_elem              = 8
+	TDTLParserRULE_field_elem_with_as      = 9
+	TDTLParserRULE_filter                  = 10
+	TDTLParserRULE_filter_condition        = 11
+	TDTLParserRULE_filter_condition_or     = 12
+	TDTLParserRULE_filter_condition_not    = 13
+	TDTLParserRULE_expr                    = 14
+	TDTLParserRULE_sourceEntity            = 15
+	TDTLParserRULE_propertyEntity          = 16
+	TDTLParserRULE_constant                = 17
+	TDTLParserRULE_switch_stmt             = 18
+	TDTLParserRULE_call_expr               = 19
+	TDTLParserRULE_asterisk                = 20
+	TDTLParserRULE_xpath_name              = 21
+	TDTLParserRULE_target_name             = 22
+	TDTLParserRULE_dotnotation             = 23
+	TDTLParserRULE_identifierWithTOPICITEM = 24
+	TDTLParserRULE_identifierWithQualifier = 25
 )
 
 // IRootContext is an interface to support dynamic dispatch.
@@ -320,29 +374,851 @@ func (s *RootContext) EOF() antlr.TerminalNode {
 	return s.GetToken(TDTLParserEOF, 0)
 }
 
+func (s *RootContext) AllClause() []IClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IClauseContext)(nil)).Elem())
+	var tst = make([]IClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *RootContext) Clause(i int) IClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClauseContext)
+}
+
 func (s *RootContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RootContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RootContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RootContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterRoot(s)
+	}
+}
+
+func (s *RootContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitRoot(s)
+	}
+}
+
+func (p *TDTLParser) Root() (localctx IRootContext) {
+	localctx = NewRootContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 0, TDTLParserRULE_root)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(55)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == TDTLParserCREATE || _la == TDTLParserIMPORT {
+		{
+			p.SetState(52)
+			p.Clause()
+		}
+
+		p.SetState(57)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(58)
+		p.Match(TDTLParserINSERT)
+	}
+	{
+		p.SetState(59)
+		p.Match(TDTLParserINTO)
+	}
+	{
+		p.SetState(60)
+		p.Target()
+	}
+	{
+		p.SetState(61)
+		p.Match(TDTLParserSELECT)
+	}
+	{
+		p.SetState(62)
+		p.Fields()
+	}
+	{
+		p.SetState(63)
+		p.Match(TDTLParserEOF)
+	}
+
+	return localctx
+}
+
+// IScriptContext is an interface to support dynamic dispatch.
+type IScriptContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsScriptContext differentiates from other interfaces.
+	IsScriptContext()
+}
+
+type ScriptContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyScriptContext() *ScriptContext {
+	var p = new(ScriptContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TDTLParserRULE_script
+	return p
+}
+
+func (*ScriptContext) IsScriptContext() {}
+
+func NewScriptContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ScriptContext {
+	var p = new(ScriptContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TDTLParserRULE_script
+
+	return p
+}
+
+func (s *ScriptContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ScriptContext) EOF() antlr.TerminalNode {
+	return s.GetToken(TDTLParserEOF, 0)
+}
+
+func (s *ScriptContext) AllClause() []IClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IClauseContext)(nil)).Elem())
+	var tst = make([]IClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ScriptContext) Clause(i int) IClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClauseContext)
+}
+
+func (s *ScriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ScriptContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ScriptContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterScript(s)
+	}
+}
+
+func (s *ScriptContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitScript(s)
+	}
+}
+
+func (p *TDTLParser) Script() (localctx IScriptContext) {
+	localctx = NewScriptContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, TDTLParserRULE_script)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(68)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == TDTLParserCREATE || _la == TDTLParserIMPORT {
+		{
+			p.SetState(65)
+			p.Clause()
+		}
+
+		p.SetState(70)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(71)
+		p.Match(TDTLParserEOF)
+	}
+
+	return localctx
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TDTLParserRULE_expression
+	return p
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TDTLParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) Field_elem() IField_elemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IField_elemContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IField_elemContext)
+}
+
+func (s *ExpressionContext) AllClause() []IClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IClauseContext)(nil)).Elem())
+	var tst = make([]IClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExpressionContext) Clause(i int) IClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClauseContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterExpression(s)
+	}
+}
+
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitExpression(s)
+	}
+}
+
+func (p *TDTLParser) Expression() (localctx IExpressionContext) {
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, TDTLParserRULE_expression)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(76)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(73)
+				p.Clause()
+			}
+
+		}
+		p.SetState(78)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+	}
+	{
+		p.SetState(79)
+		p.Field_elem()
+	}
+
+	return localctx
+}
+
+// IClauseContext is an interface to support dynamic dispatch.
+type IClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsClauseContext differentiates from other interfaces.
+	IsClauseContext()
+}
+
+type ClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyClauseContext() *ClauseContext {
+	var p = new(ClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TDTLParserRULE_clause
+	return p
+}
+
+func (*ClauseContext) IsClauseContext() {}
+
+func NewClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClauseContext {
+	var p = new(ClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TDTLParserRULE_clause
+
+	return p
+}
+
+func (s *ClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ClauseContext) CopyFrom(ctx *ClauseContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type ImportContext struct {
+	*ClauseContext
+}
+
+func NewImportContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ImportContext {
+	var p = new(ImportContext)
+
+	p.ClauseContext = NewEmptyClauseContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClauseContext))
+
+	return p
+}
+
+func (s *ImportContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *ImportContext) AllImport_elem() []IImport_elemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IImport_elemContext)(nil)).Elem())
+	var tst = make([]IImport_elemContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IImport_elemContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ImportContext) Import_elem(i int) IImport_elemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IImport_elemContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImport_elemContext)
+}
+
+func (s *ImportContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterImport(s)
+	}
+}
+
+func (s *ImportContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitImport(s)
+	}
+}
+
+type CreateFunctionContext struct {
+	*ClauseContext
+	name antlr.Token
+}
+
+func NewCreateFunctionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CreateFunctionContext {
+	var p = new(CreateFunctionContext)
+
+	p.ClauseContext = NewEmptyClauseContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClauseContext))
+
+	return p
+}
+
+func (s *CreateFunctionContext) GetName() antlr.Token { return s.name }
+
+func (s *CreateFunctionContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *CreateFunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateFunctionContext) AllCREATE() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserCREATE)
+}
+
+func (s *CreateFunctionContext) CREATE(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, i)
+}
+
+func (s *CreateFunctionContext) AllFUNCTION() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserFUNCTION)
+}
+
+func (s *CreateFunctionContext) FUNCTION(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, i)
+}
+
+func (s *CreateFunctionContext) AS() antlr.TerminalNode {
+	return s.GetToken(TDTLParserAS, 0)
+}
+
+func (s *CreateFunctionContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CreateFunctionContext) INDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINDENTIFIER, 0)
+}
+
+func (s *CreateFunctionContext) PATHITEM() antlr.TerminalNode {
+	return s.GetToken(TDTLParserPATHITEM, 0)
+}
+
+func (s *CreateFunctionContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *CreateFunctionContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *CreateFunctionContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *CreateFunctionContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
+func (s *CreateFunctionContext) Param_list() IParam_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParam_listContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParam_listContext)
+}
+
+func (s *CreateFunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterCreateFunction(s)
+	}
+}
+
+func (s *CreateFunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitCreateFunction(s)
+	}
+}
+
+func (p *TDTLParser) Clause() (localctx IClauseContext) {
+	localctx = NewClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, TDTLParserRULE_clause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(100)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case TDTLParserIMPORT:
+		localctx = NewImportContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(81)
+			p.Match(TDTLParserIMPORT)
+		}
+		{
+			p.SetState(82)
+			p.Import_elem()
+		}
+		p.SetState(87)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == TDTLParserT__0 {
+			{
+				p.SetState(83)
+				p.Match(TDTLParserT__0)
+			}
+			{
+				p.SetState(84)
+				p.Import_elem()
+			}
+
+			p.SetState(89)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case TDTLParserCREATE:
+		localctx = NewCreateFunctionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(90)
+			p.Match(TDTLParserCREATE)
+		}
+		{
+			p.SetState(91)
+			p.Match(TDTLParserFUNCTION)
+		}
+		p.SetState(92)
+
+		var _lt = p.GetTokenStream().LT(1)
+
+		localctx.(*CreateFunctionContext).name = _lt
+
+		_la = p.GetTokenStream().LA(1)
+
+		if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0)) {
+			var _ri = p.GetErrorHandler().RecoverInline(p)
+
+			localctx.(*CreateFunctionContext).name = _ri
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+		{
+			p.SetState(93)
+			p.Match(TDTLParserT__1)
+		}
+		p.SetState(95)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if ((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0 {
+			{
+				p.SetState(94)
+				p.Param_list()
+			}
+
+		}
+		{
+			p.SetState(97)
+			p.Match(TDTLParserT__2)
+		}
+		{
+			p.SetState(98)
+			p.Match(TDTLParserAS)
+		}
+		{
+			p.SetState(99)
+			p.expr(0)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IImport_elemContext is an interface to support dynamic dispatch.
+type IImport_elemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetName returns the name token.
+	GetName() antlr.Token
+
+	// GetAlias returns the alias token.
+	GetAlias() antlr.Token
+
+	// SetName sets the name token.
+	SetName(antlr.Token)
+
+	// SetAlias sets the alias token.
+	SetAlias(antlr.Token)
+
+	// IsImport_elemContext differentiates from other interfaces.
+	IsImport_elemContext()
+}
+
+type Import_elemContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	name   antlr.Token
+	alias  antlr.Token
+}
+
+func NewEmptyImport_elemContext() *Import_elemContext {
+	var p = new(Import_elemContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TDTLParserRULE_import_elem
+	return p
+}
+
+func (*Import_elemContext) IsImport_elemContext() {}
+
+func NewImport_elemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Import_elemContext {
+	var p = new(Import_elemContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TDTLParserRULE_import_elem
+
+	return p
+}
+
+func (s *Import_elemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Import_elemContext) GetName() antlr.Token { return s.name }
+
+func (s *Import_elemContext) GetAlias() antlr.Token { return s.alias }
+
+func (s *Import_elemContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *Import_elemContext) SetAlias(v antlr.Token) { s.alias = v }
+
+func (s *Import_elemContext) AllINDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserINDENTIFIER)
+}
+
+func (s *Import_elemContext) INDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserINDENTIFIER, i)
+}
+
+func (s *Import_elemContext) PATHITEM() antlr.TerminalNode {
+	return s.GetToken(TDTLParserPATHITEM, 0)
+}
+
+func (s *Import_elemContext) AllCREATE() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserCREATE)
+}
+
+func (s *Import_elemContext) CREATE(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, i)
+}
+
+func (s *Import_elemContext) AllDECIMAL() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserDECIMAL)
+}
+
+func (s *Import_elemContext) DECIMAL(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, i)
+}
+
+func (s *Import_elemContext) AllFUNCTION() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserFUNCTION)
+}
+
+func (s *Import_elemContext) FUNCTION(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, i)
+}
+
+func (s *Import_elemContext) AllIMPORT() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserIMPORT)
+}
+
+func (s *Import_elemContext) IMPORT(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, i)
+}
+
+func (s *Import_elemContext) AllINTERVAL() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserINTERVAL)
+}
+
+func (s *Import_elemContext) INTERVAL(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, i)
+}
+
+func (s *Import_elemContext) AllTIMESTAMP() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserTIMESTAMP)
+}
+
+func (s *Import_elemContext) TIMESTAMP(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, i)
+}
+
+func (s *Import_elemContext) AS() antlr.TerminalNode {
+	return s.GetToken(TDTLParserAS, 0)
+}
+
+func (s *Import_elemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Import_elemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RootContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Import_elemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TDTLListener); ok {
-		listenerT.EnterRoot(s)
+		listenerT.EnterImport_elem(s)
 	}
 }
 
-func (s *RootContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Import_elemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TDTLListener); ok {
-		listenerT.ExitRoot(s)
+		listenerT.ExitImport_elem(s)
 	}
 }
 
-func (p *TDTLParser) Root() (localctx IRootContext) {
-	localctx = NewRootContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 0, TDTLParserRULE_root)
+func (p *TDTLParser) Import_elem() (localctx IImport_elemContext) {
+	localctx = NewImport_elemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, TDTLParserRULE_import_elem)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -361,29 +1237,220 @@ func (p *TDTLParser) Root() (localctx IRootContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(42)
-		p.Match(TDTLParserINSERT)
+	p.SetState(102)
+
+	var _lt = p.GetTokenStream().LT(1)
+
+	localctx.(*Import_elemContext).name = _lt
+
+	_la = p.GetTokenStream().LA(1)
+
+	if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0)) {
+		var _ri = p.GetErrorHandler().RecoverInline(p)
+
+		localctx.(*Import_elemContext).name = _ri
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	{
-		p.SetState(43)
-		p.Match(TDTLParserINTO)
+	p.SetState(105)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == TDTLParserAS {
+		{
+			p.SetState(103)
+			p.Match(TDTLParserAS)
+		}
+		p.SetState(104)
+
+		var _lt = p.GetTokenStream().LT(1)
+
+		localctx.(*Import_elemContext).alias = _lt
+
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0) {
+			var _ri = p.GetErrorHandler().RecoverInline(p)
+
+			localctx.(*Import_elemContext).alias = _ri
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+
 	}
-	{
-		p.SetState(44)
-		p.Target()
+
+	return localctx
+}
+
+// IParam_listContext is an interface to support dynamic dispatch.
+type IParam_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsParam_listContext differentiates from other interfaces.
+	IsParam_listContext()
+}
+
+type Param_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyParam_listContext() *Param_listContext {
+	var p = new(Param_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = TDTLParserRULE_param_list
+	return p
+}
+
+func (*Param_listContext) IsParam_listContext() {}
+
+func NewParam_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Param_listContext {
+	var p = new(Param_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = TDTLParserRULE_param_list
+
+	return p
+}
+
+func (s *Param_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Param_listContext) AllINDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserINDENTIFIER)
+}
+
+func (s *Param_listContext) INDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserINDENTIFIER, i)
+}
+
+func (s *Param_listContext) AllCREATE() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserCREATE)
+}
+
+func (s *Param_listContext) CREATE(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, i)
+}
+
+func (s *Param_listContext) AllDECIMAL() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserDECIMAL)
+}
+
+func (s *Param_listContext) DECIMAL(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, i)
+}
+
+func (s *Param_listContext) AllFUNCTION() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserFUNCTION)
+}
+
+func (s *Param_listContext) FUNCTION(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, i)
+}
+
+func (s *Param_listContext) AllIMPORT() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserIMPORT)
+}
+
+func (s *Param_listContext) IMPORT(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, i)
+}
+
+func (s *Param_listContext) AllINTERVAL() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserINTERVAL)
+}
+
+func (s *Param_listContext) INTERVAL(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, i)
+}
+
+func (s *Param_listContext) AllTIMESTAMP() []antlr.TerminalNode {
+	return s.GetTokens(TDTLParserTIMESTAMP)
+}
+
+func (s *Param_listContext) TIMESTAMP(i int) antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, i)
+}
+
+func (s *Param_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Param_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Param_listContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterParam_list(s)
 	}
-	{
-		p.SetState(45)
-		p.Match(TDTLParserSELECT)
+}
+
+func (s *Param_listContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitParam_list(s)
 	}
-	{
-		p.SetState(46)
-		p.Fields()
+}
+
+func (p *TDTLParser) Param_list() (localctx IParam_listContext) {
+	localctx = NewParam_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, TDTLParserRULE_param_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(107)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	{
-		p.SetState(47)
-		p.Match(TDTLParserEOF)
+	p.SetState(112)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == TDTLParserT__0 {
+		{
+			p.SetState(108)
+			p.Match(TDTLParserT__0)
+		}
+		p.SetState(109)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+
+		p.SetState(114)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
@@ -431,6 +1498,30 @@ func (s *TargetContext) INDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(TDTLParserINDENTIFIER, 0)
 }
 
+func (s *TargetContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, 0)
+}
+
+func (s *TargetContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *TargetContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, 0)
+}
+
+func (s *TargetContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *TargetContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *TargetContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
 func (s *TargetContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -453,7 +1544,8 @@ func (s *TargetContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Target() (localctx ITargetContext) {
 	localctx = NewTargetContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, TDTLParserRULE_target)
+	p.EnterRule(localctx, 12, TDTLParserRULE_target)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -472,9 +1564,14 @@ func (p *TDTLParser) Target() (localctx ITargetContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(49)
-		p.Match(TDTLParserINDENTIFIER)
+	p.SetState(115)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
@@ -563,7 +1660,7 @@ func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Fields() (localctx IFieldsContext) {
 	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, TDTLParserRULE_fields)
+	p.EnterRule(localctx, 14, TDTLParserRULE_fields)
 	var _la int
 
 	defer func() {
@@ -584,24 +1681,24 @@ func (p *TDTLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(51)
+		p.SetState(117)
 		p.Field_elem()
 	}
-	p.SetState(56)
+	p.SetState(122)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == TDTLParserT__0 {
 		{
-			p.SetState(52)
+			p.SetState(118)
 			p.Match(TDTLParserT__0)
 		}
 		{
-			p.SetState(53)
+			p.SetState(119)
 			p.Field_elem()
 		}
 
-		p.SetState(58)
+		p.SetState(124)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -791,7 +1888,7 @@ func (s *FieldElemAsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Field_elem() (localctx IField_elemContext) {
 	localctx = NewField_elemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, TDTLParserRULE_field_elem)
+	p.EnterRule(localctx, 16, TDTLParserRULE_field_elem)
 
 	defer func() {
 		p.ExitRule()
@@ -809,14 +1906,14 @@ func (p *TDTLParser) Field_elem() (localctx IField_elemContext) {
 		}
 	}()
 
-	p.SetState(65)
+	p.SetState(131)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewFieldElemAsContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(59)
+			p.SetState(125)
 			p.Field_elem_with_as()
 		}
 
@@ -824,15 +1921,15 @@ func (p *TDTLParser) Field_elem() (localctx IField_elemContext) {
 		localctx = NewFieldElemSourceContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(60)
+			p.SetState(126)
 			p.SourceEntity()
 		}
 		{
-			p.SetState(61)
+			p.SetState(127)
 			p.Match(TDTLParserDOT)
 		}
 		{
-			p.SetState(62)
+			p.SetState(128)
 			p.Asterisk()
 		}
 
@@ -840,7 +1937,7 @@ func (p *TDTLParser) Field_elem() (localctx IField_elemContext) {
 		localctx = NewFieldElemExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(64)
+			p.SetState(130)
 			p.expr(0)
 		}
 
@@ -955,7 +2052,7 @@ func (s *TargetAsElemContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Field_elem_with_as() (localctx IField_elem_with_asContext) {
 	localctx = NewField_elem_with_asContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, TDTLParserRULE_field_elem_with_as)
+	p.EnterRule(localctx, 18, TDTLParserRULE_field_elem_with_as)
 
 	defer func() {
 		p.ExitRule()
@@ -976,15 +2073,15 @@ func (p *TDTLParser) Field_elem_with_as() (localctx IField_elem_with_asContext)
 	localctx = NewTargetAsElemContext(p, localctx)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(67)
+		p.SetState(133)
 		p.expr(0)
 	}
 	{
-		p.SetState(68)
+		p.SetState(134)
 		p.Match(TDTLParserAS)
 	}
 	{
-		p.SetState(69)
+		p.SetState(135)
 		p.Target_name()
 	}
 
@@ -1061,7 +2158,7 @@ func (s *FilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Filter() (localctx IFilterContext) {
 	localctx = NewFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, TDTLParserRULE_filter)
+	p.EnterRule(localctx, 20, TDTLParserRULE_filter)
 
 	defer func() {
 		p.ExitRule()
@@ -1081,7 +2178,7 @@ func (p *TDTLParser) Filter() (localctx IFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(71)
+		p.SetState(137)
 		p.Filter_condition()
 	}
 
@@ -1179,7 +2276,7 @@ func (s *Filter_conditionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Filter_condition() (localctx IFilter_conditionContext) {
 	localctx = NewFilter_conditionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, TDTLParserRULE_filter_condition)
+	p.EnterRule(localctx, 22, TDTLParserRULE_filter_condition)
 	var _la int
 
 	defer func() {
@@ -1200,24 +2297,24 @@ func (p *TDTLParser) Filter_condition() (localctx IFilter_conditionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(73)
+		p.SetState(139)
 		p.Filter_condition_or()
 	}
-	p.SetState(78)
+	p.SetState(144)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == TDTLParserAND {
 		{
-			p.SetState(74)
+			p.SetState(140)
 			p.Match(TDTLParserAND)
 		}
 		{
-			p.SetState(75)
+			p.SetState(141)
 			p.Filter_condition_or()
 		}
 
-		p.SetState(80)
+		p.SetState(146)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1316,7 +2413,7 @@ func (s *Filter_condition_orContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *TDTLParser) Filter_condition_or() (localctx IFilter_condition_orContext) {
 	localctx = NewFilter_condition_orContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, TDTLParserRULE_filter_condition_or)
+	p.EnterRule(localctx, 24, TDTLParserRULE_filter_condition_or)
 	var _la int
 
 	defer func() {
@@ -1337,24 +2434,24 @@ func (p *TDTLParser) Filter_condition_or() (localctx IFilter_condition_orContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(81)
+		p.SetState(147)
 		p.Filter_condition_not()
 	}
-	p.SetState(86)
+	p.SetState(152)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == TDTLParserOR {
 		{
-			p.SetState(82)
+			p.SetState(148)
 			p.Match(TDTLParserOR)
 		}
 		{
-			p.SetState(83)
+			p.SetState(149)
 			p.Filter_condition_not()
 		}
 
-		p.SetState(88)
+		p.SetState(154)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1436,7 +2533,7 @@ func (s *Filter_condition_notContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *TDTLParser) Filter_condition_not() (localctx IFilter_condition_notContext) {
 	localctx = NewFilter_condition_notContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, TDTLParserRULE_filter_condition_not)
+	p.EnterRule(localctx, 26, TDTLParserRULE_filter_condition_not)
 	var _la int
 
 	defer func() {
@@ -1456,19 +2553,19 @@ func (p *TDTLParser) Filter_condition_not() (localctx IFilter_condition_notConte
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(90)
+	p.SetState(156)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == TDTLParserNOT {
 		{
-			p.SetState(89)
+			p.SetState(155)
 			p.Match(TDTLParserNOT)
 		}
 
 	}
 	{
-		p.SetState(92)
+		p.SetState(158)
 		p.expr(0)
 	}
 
@@ -1655,6 +2752,67 @@ func (s *SwitchContext) ExitRule(listener antlr.ParseTreeListener) {
 	}
 }
 
+type InContext struct {
+	*ExprContext
+}
+
+func NewInContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InContext {
+	var p = new(InContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *InContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *InContext) IN() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIN, 0)
+}
+
+func (s *InContext) NOT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserNOT, 0)
+}
+
+func (s *InContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterIn(s)
+	}
+}
+
+func (s *InContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitIn(s)
+	}
+}
+
 type BinaryContext struct {
 	*ExprContext
 	op antlr.Token
@@ -1747,8 +2905,8 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 18
-	p.EnterRecursionRule(localctx, 18, TDTLParserRULE_expr, _p)
+	_startState := 28
+	p.EnterRecursionRule(localctx, 28, TDTLParserRULE_expr, _p)
 	var _la int
 
 	defer func() {
@@ -1770,16 +2928,16 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(102)
+	p.SetState(168)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewBracesContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(95)
+			p.SetState(161)
 			p.Constant()
 		}
 
@@ -1788,15 +2946,15 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(96)
+			p.SetState(162)
 			p.Match(TDTLParserT__1)
 		}
 		{
-			p.SetState(97)
+			p.SetState(163)
 			p.expr(0)
 		}
 		{
-			p.SetState(98)
+			p.SetState(164)
 			p.Match(TDTLParserT__2)
 		}
 
@@ -1805,7 +2963,7 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(100)
+			p.SetState(166)
 			p.Call_expr()
 		}
 
@@ -1814,15 +2972,15 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(101)
+			p.SetState(167)
 			p.Switch_stmt()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(115)
+	p.SetState(197)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -1830,18 +2988,18 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(113)
+			p.SetState(195)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewBinaryContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, TDTLParserRULE_expr)
-				p.SetState(104)
+				p.SetState(170)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
-				p.SetState(105)
+				p.SetState(171)
 
 				var _lt = p.GetTokenStream().LT(1)
 
@@ -1849,7 +3007,7 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 
 				_la = p.GetTokenStream().LA(1)
 
-				if !(((_la-31)&-(0x1f+1)) == 0 && ((1<<uint((_la-31)))&((1<<(TDTLParserMUL-31))|(1<<(TDTLParserDIV-31))|(1<<(TDTLParserMOD-31)))) != 0) {
+				if !(((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(TDTLParserMUL-38))|(1<<(TDTLParserDIV-38))|(1<<(TDTLParserMOD-38)))) != 0) {
 					var _ri = p.GetErrorHandler().RecoverInline(p)
 
 					localctx.(*BinaryContext).op = _ri
@@ -1858,19 +3016,19 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 					p.Consume()
 				}
 				{
-					p.SetState(106)
-					p.expr(6)
+					p.SetState(172)
+					p.expr(7)
 				}
 
 			case 2:
 				localctx = NewBinaryContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, TDTLParserRULE_expr)
-				p.SetState(107)
+				p.SetState(173)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
-				p.SetState(108)
+				p.SetState(174)
 
 				var _lt = p.GetTokenStream().LT(1)
 
@@ -1887,19 +3045,19 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 					p.Consume()
 				}
 				{
-					p.SetState(109)
-					p.expr(5)
+					p.SetState(175)
+					p.expr(6)
 				}
 
 			case 3:
 				localctx = NewBinaryContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, TDTLParserRULE_expr)
-				p.SetState(110)
+				p.SetState(176)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
-				p.SetState(111)
+				p.SetState(177)
 
 				var _lt = p.GetTokenStream().LT(1)
 
@@ -1916,16 +3074,70 @@ func (p *TDTLParser) expr(_p int) (localctx IExprContext) {
 					p.Consume()
 				}
 				{
-					p.SetState(112)
-					p.expr(4)
+					p.SetState(178)
+					p.expr(5)
+				}
+
+			case 4:
+				localctx = NewInContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, TDTLParserRULE_expr)
+				p.SetState(179)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				p.SetState(181)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == TDTLParserNOT {
+					{
+						p.SetState(180)
+						p.Match(TDTLParserNOT)
+					}
+
+				}
+				{
+					p.SetState(183)
+					p.Match(TDTLParserIN)
+				}
+				{
+					p.SetState(184)
+					p.Match(TDTLParserT__1)
+				}
+				{
+					p.SetState(185)
+					p.expr(0)
+				}
+				p.SetState(190)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				for _la == TDTLParserT__0 {
+					{
+						p.SetState(186)
+						p.Match(TDTLParserT__0)
+					}
+					{
+						p.SetState(187)
+						p.expr(0)
+					}
+
+					p.SetState(192)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
+				}
+				{
+					p.SetState(193)
+					p.Match(TDTLParserT__2)
 				}
 
 			}
 
 		}
-		p.SetState(117)
+		p.SetState(199)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -1973,6 +3185,30 @@ func (s *SourceEntityContext) INDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(TDTLParserINDENTIFIER, 0)
 }
 
+func (s *SourceEntityContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, 0)
+}
+
+func (s *SourceEntityContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *SourceEntityContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, 0)
+}
+
+func (s *SourceEntityContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *SourceEntityContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *SourceEntityContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
 func (s *SourceEntityContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1995,7 +3231,8 @@ func (s *SourceEntityContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) SourceEntity() (localctx ISourceEntityContext) {
 	localctx = NewSourceEntityContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, TDTLParserRULE_sourceEntity)
+	p.EnterRule(localctx, 30, TDTLParserRULE_sourceEntity)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2014,9 +3251,14 @@ func (p *TDTLParser) SourceEntity() (localctx ISourceEntityContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(118)
-		p.Match(TDTLParserINDENTIFIER)
+	p.SetState(200)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la-15)&-(0x1f+1)) == 0 && ((1<<uint((_la-15)))&((1<<(TDTLParserCREATE-15))|(1<<(TDTLParserDECIMAL-15))|(1<<(TDTLParserFUNCTION-15))|(1<<(TDTLParserIMPORT-15))|(1<<(TDTLParserINTERVAL-15))|(1<<(TDTLParserTIMESTAMP-15))|(1<<(TDTLParserINDENTIFIER-15)))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
@@ -2090,7 +3332,7 @@ func (s *PropertyEntityContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) PropertyEntity() (localctx IPropertyEntityContext) {
 	localctx = NewPropertyEntityContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, TDTLParserRULE_propertyEntity)
+	p.EnterRule(localctx, 32, TDTLParserRULE_propertyEntity)
 	var _la int
 
 	defer func() {
@@ -2110,21 +3352,21 @@ func (p *TDTLParser) PropertyEntity() (localctx IPropertyEntityContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(122)
+	p.SetState(204)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == TDTLParserDOT {
 		{
-			p.SetState(120)
+			p.SetState(202)
 			p.Match(TDTLParserDOT)
 		}
 		{
-			p.SetState(121)
+			p.SetState(203)
 			p.Match(TDTLParserINDENTIFIER)
 		}
 
-		p.SetState(124)
+		p.SetState(206)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -2254,6 +3496,57 @@ func (s *FloatContext) ExitRule(listener antlr.ParseTreeListener) {
 	}
 }
 
+type TypedLiteralContext struct {
+	*ConstantContext
+	kind antlr.Token
+}
+
+func NewTypedLiteralContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypedLiteralContext {
+	var p = new(TypedLiteralContext)
+
+	p.ConstantContext = NewEmptyConstantContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ConstantContext))
+
+	return p
+}
+
+func (s *TypedLiteralContext) GetKind() antlr.Token { return s.kind }
+
+func (s *TypedLiteralContext) SetKind(v antlr.Token) { s.kind = v }
+
+func (s *TypedLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypedLiteralContext) STRING() antlr.TerminalNode {
+	return s.GetToken(TDTLParserSTRING, 0)
+}
+
+func (s *TypedLiteralContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *TypedLiteralContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *TypedLiteralContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
+func (s *TypedLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.EnterTypedLiteral(s)
+	}
+}
+
+func (s *TypedLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(TDTLListener); ok {
+		listenerT.ExitTypedLiteral(s)
+	}
+}
+
 type StringContext struct {
 	*ConstantContext
 }
@@ -2368,7 +3661,8 @@ func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Constant() (localctx IConstantContext) {
 	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, TDTLParserRULE_constant)
+	p.EnterRule(localctx, 34, TDTLParserRULE_constant)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2386,68 +3680,89 @@ func (p *TDTLParser) Constant() (localctx IConstantContext) {
 		}
 	}()
 
-	p.SetState(133)
+	p.SetState(217)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case TDTLParserTRUE:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
+	case 1:
 		localctx = NewBooleanContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(126)
+			p.SetState(208)
 			p.Match(TDTLParserTRUE)
 		}
 
-	case TDTLParserFALSE:
+	case 2:
 		localctx = NewBooleanContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(127)
+			p.SetState(209)
 			p.Match(TDTLParserFALSE)
 		}
 
-	case TDTLParserNUMBER:
+	case 3:
 		localctx = NewIntegerContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(128)
+			p.SetState(210)
 			p.Match(TDTLParserNUMBER)
 		}
 
-	case TDTLParserINTEGER:
+	case 4:
 		localctx = NewIntegerContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(129)
+			p.SetState(211)
 			p.Match(TDTLParserINTEGER)
 		}
 
-	case TDTLParserFLOAT:
+	case 5:
 		localctx = NewFloatContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(130)
+			p.SetState(212)
 			p.Match(TDTLParserFLOAT)
 		}
 
-	case TDTLParserSTRING:
+	case 6:
 		localctx = NewStringContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(131)
+			p.SetState(213)
 			p.Match(TDTLParserSTRING)
 		}
 
-	case TDTLParserT__3, TDTLParserINDENTIFIER, TDTLParserPATHITEM:
-		localctx = NewSourceContext(p, localctx)
+	case 7:
+		localctx = NewTypedLiteralContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
+		p.SetState(214)
+
+		var _lt = p.GetTokenStream().LT(1)
+
+		localctx.(*TypedLiteralContext).kind = _lt
+
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-16)&-(0x1f+1)) == 0 && ((1<<uint((_la-16)))&((1<<(TDTLParserDECIMAL-16))|(1<<(TDTLParserINTERVAL-16))|(1<<(TDTLParserTIMESTAMP-16)))) != 0) {
+			var _ri = p.GetErrorHandler().RecoverInline(p)
+
+			localctx.(*TypedLiteralContext).kind = _ri
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+		{
+			p.SetState(215)
+			p.Match(TDTLParserSTRING)
+		}
+
+	case 8:
+		localctx = NewSourceContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(132)
+			p.SetState(216)
 			p.Xpath_name()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -2560,7 +3875,7 @@ func (s *Switch_stmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Switch_stmt() (localctx ISwitch_stmtContext) {
 	localctx = NewSwitch_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, TDTLParserRULE_switch_stmt)
+	p.EnterRule(localctx, 36, TDTLParserRULE_switch_stmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2582,67 +3897,67 @@ func (p *TDTLParser) Switch_stmt() (localctx ISwitch_stmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(135)
+		p.SetState(219)
 		p.Match(TDTLParserCASE)
 	}
 	{
-		p.SetState(136)
+		p.SetState(220)
 		p.expr(0)
 	}
 	{
-		p.SetState(137)
+		p.SetState(221)
 		p.Match(TDTLParserWHEN)
 	}
 	{
-		p.SetState(138)
+		p.SetState(222)
 		p.expr(0)
 	}
 	{
-		p.SetState(139)
+		p.SetState(223)
 		p.Match(TDTLParserTHEN)
 	}
 	{
-		p.SetState(140)
+		p.SetState(224)
 		p.expr(0)
 	}
-	p.SetState(148)
+	p.SetState(232)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(141)
+				p.SetState(225)
 				p.Match(TDTLParserWHEN)
 			}
 			{
-				p.SetState(142)
+				p.SetState(226)
 				p.expr(0)
 			}
 			{
-				p.SetState(143)
+				p.SetState(227)
 				p.Match(TDTLParserTHEN)
 			}
 			{
-				p.SetState(144)
+				p.SetState(228)
 				p.expr(0)
 			}
 
 		}
-		p.SetState(150)
+		p.SetState(234)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
 	}
-	p.SetState(153)
+	p.SetState(237)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(151)
+			p.SetState(235)
 			p.Match(TDTLParserELSE)
 		}
 		{
-			p.SetState(152)
+			p.SetState(236)
 			p.expr(0)
 		}
 
@@ -2704,6 +4019,34 @@ func (s *Call_exprContext) INDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(TDTLParserINDENTIFIER, 0)
 }
 
+func (s *Call_exprContext) PATHITEM() antlr.TerminalNode {
+	return s.GetToken(TDTLParserPATHITEM, 0)
+}
+
+func (s *Call_exprContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, 0)
+}
+
+func (s *Call_exprContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *Call_exprContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, 0)
+}
+
+func (s *Call_exprContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *Call_exprContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *Call_exprContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
 func (s *Call_exprContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
@@ -2749,7 +4092,7 @@ func (s *Call_exprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Call_expr() (localctx ICall_exprContext) {
 	localctx = NewCall_exprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, TDTLParserRULE_call_expr)
+	p.EnterRule(localctx, 38, TDTLParserRULE_call_expr)
 	var _la int
 
 	defer func() {
@@ -2769,48 +4112,57 @@ func (p *TDTLParser) Call_expr() (localctx ICall_exprContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(155)
+	p.SetState(239)
+
+	var _lt = p.GetTokenStream().LT(1)
+
+	localctx.(*Call_exprContext).key = _lt
+
+	_la = p.GetTokenStream().LA(1)
 
-		var _m = p.Match(TDTLParserINDENTIFIER)
+	if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0)) {
+		var _ri = p.GetErrorHandler().RecoverInline(p)
 
-		localctx.(*Call_exprContext).key = _m
+		localctx.(*Call_exprContext).key = _ri
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 	{
-		p.SetState(156)
+		p.SetState(240)
 		p.Match(TDTLParserT__1)
 	}
-	p.SetState(165)
+	p.SetState(249)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserT__1)|(1<<TDTLParserT__3)|(1<<TDTLParserCASE))) != 0) || (((_la-37)&-(0x1f+1)) == 0 && ((1<<uint((_la-37)))&((1<<(TDTLParserTRUE-37))|(1<<(TDTLParserFALSE-37))|(1<<(TDTLParserINDENTIFIER-37))|(1<<(TDTLParserNUMBER-37))|(1<<(TDTLParserINTEGER-37))|(1<<(TDTLParserFLOAT-37))|(1<<(TDTLParserPATHITEM-37))|(1<<(TDTLParserSTRING-37)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserT__1)|(1<<TDTLParserT__3)|(1<<TDTLParserCASE)|(1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserTRUE-35))|(1<<(TDTLParserFALSE-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserNUMBER-35))|(1<<(TDTLParserINTEGER-35))|(1<<(TDTLParserFLOAT-35))|(1<<(TDTLParserPATHITEM-35))|(1<<(TDTLParserSTRING-35)))) != 0) {
 		{
-			p.SetState(157)
+			p.SetState(241)
 			p.expr(0)
 		}
-		p.SetState(162)
+		p.SetState(246)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == TDTLParserT__0 {
 			{
-				p.SetState(158)
+				p.SetState(242)
 				p.Match(TDTLParserT__0)
 			}
 			{
-				p.SetState(159)
+				p.SetState(243)
 				p.expr(0)
 			}
 
-			p.SetState(164)
+			p.SetState(248)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(167)
+		p.SetState(251)
 		p.Match(TDTLParserT__2)
 	}
 
@@ -2876,7 +4228,7 @@ func (s *AsteriskContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Asterisk() (localctx IAsteriskContext) {
 	localctx = NewAsteriskContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, TDTLParserRULE_asterisk)
+	p.EnterRule(localctx, 40, TDTLParserRULE_asterisk)
 
 	defer func() {
 		p.ExitRule()
@@ -2896,7 +4248,7 @@ func (p *TDTLParser) Asterisk() (localctx IAsteriskContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(169)
+		p.SetState(253)
 		p.Match(TDTLParserMUL)
 	}
 
@@ -2986,7 +4338,7 @@ func (s *Xpath_nameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Xpath_name() (localctx IXpath_nameContext) {
 	localctx = NewXpath_nameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, TDTLParserRULE_xpath_name)
+	p.EnterRule(localctx, 42, TDTLParserRULE_xpath_name)
 	var _la int
 
 	defer func() {
@@ -3005,49 +4357,49 @@ func (p *TDTLParser) Xpath_name() (localctx IXpath_nameContext) {
 		}
 	}()
 
-	p.SetState(184)
+	p.SetState(268)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case TDTLParserINDENTIFIER, TDTLParserPATHITEM:
+	case TDTLParserCREATE, TDTLParserDECIMAL, TDTLParserFUNCTION, TDTLParserIMPORT, TDTLParserINTERVAL, TDTLParserTIMESTAMP, TDTLParserINDENTIFIER, TDTLParserPATHITEM:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(171)
+			p.SetState(255)
 			p.Dotnotation()
 		}
 
 	case TDTLParserT__3:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(173)
+		p.SetState(257)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == TDTLParserT__3 {
 			{
-				p.SetState(172)
+				p.SetState(256)
 				p.Match(TDTLParserT__3)
 			}
 
-			p.SetState(175)
+			p.SetState(259)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(178)
+		p.SetState(262)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ok := true; ok; ok = _la == TDTLParserINDENTIFIER || _la == TDTLParserPATHITEM {
+		for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0) {
 			{
-				p.SetState(177)
+				p.SetState(261)
 				p.Dotnotation()
 			}
 
-			p.SetState(180)
+			p.SetState(264)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(182)
+			p.SetState(266)
 			p.Match(TDTLParserT__3)
 		}
 
@@ -3141,7 +4493,7 @@ func (s *Target_nameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Target_name() (localctx ITarget_nameContext) {
 	localctx = NewTarget_nameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, TDTLParserRULE_target_name)
+	p.EnterRule(localctx, 44, TDTLParserRULE_target_name)
 	var _la int
 
 	defer func() {
@@ -3160,49 +4512,49 @@ func (p *TDTLParser) Target_name() (localctx ITarget_nameContext) {
 		}
 	}()
 
-	p.SetState(199)
+	p.SetState(283)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case TDTLParserINDENTIFIER, TDTLParserPATHITEM:
+	case TDTLParserCREATE, TDTLParserDECIMAL, TDTLParserFUNCTION, TDTLParserIMPORT, TDTLParserINTERVAL, TDTLParserTIMESTAMP, TDTLParserINDENTIFIER, TDTLParserPATHITEM:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
+			p.SetState(270)
 			p.Dotnotation()
 		}
 
 	case TDTLParserT__3:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(188)
+		p.SetState(272)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == TDTLParserT__3 {
 			{
-				p.SetState(187)
+				p.SetState(271)
 				p.Match(TDTLParserT__3)
 			}
 
-			p.SetState(190)
+			p.SetState(274)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(193)
+		p.SetState(277)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ok := true; ok; ok = _la == TDTLParserINDENTIFIER || _la == TDTLParserPATHITEM {
+		for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0) {
 			{
-				p.SetState(192)
+				p.SetState(276)
 				p.Dotnotation()
 			}
 
-			p.SetState(195)
+			p.SetState(279)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(197)
+			p.SetState(281)
 			p.Match(TDTLParserT__3)
 		}
 
@@ -3259,6 +4611,30 @@ func (s *DotnotationContext) PATHITEM() antlr.TerminalNode {
 	return s.GetToken(TDTLParserPATHITEM, 0)
 }
 
+func (s *DotnotationContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(TDTLParserCREATE, 0)
+}
+
+func (s *DotnotationContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserDECIMAL, 0)
+}
+
+func (s *DotnotationContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(TDTLParserFUNCTION, 0)
+}
+
+func (s *DotnotationContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(TDTLParserIMPORT, 0)
+}
+
+func (s *DotnotationContext) INTERVAL() antlr.TerminalNode {
+	return s.GetToken(TDTLParserINTERVAL, 0)
+}
+
+func (s *DotnotationContext) TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(TDTLParserTIMESTAMP, 0)
+}
+
 func (s *DotnotationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3281,7 +4657,7 @@ func (s *DotnotationContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *TDTLParser) Dotnotation() (localctx IDotnotationContext) {
 	localctx = NewDotnotationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, TDTLParserRULE_dotnotation)
+	p.EnterRule(localctx, 46, TDTLParserRULE_dotnotation)
 	var _la int
 
 	defer func() {
@@ -3301,10 +4677,10 @@ func (p *TDTLParser) Dotnotation() (localctx IDotnotationContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(201)
+	p.SetState(285)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(_la == TDTLParserINDENTIFIER || _la == TDTLParserPATHITEM) {
+	if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<TDTLParserCREATE)|(1<<TDTLParserDECIMAL)|(1<<TDTLParserFUNCTION)|(1<<TDTLParserIMPORT)|(1<<TDTLParserINTERVAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(TDTLParserTIMESTAMP-35))|(1<<(TDTLParserINDENTIFIER-35))|(1<<(TDTLParserPATHITEM-35)))) != 0)) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -3386,7 +4762,7 @@ func (s *IdentifierWithTOPICITEMContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *TDTLParser) IdentifierWithTOPICITEM() (localctx IIdentifierWithTOPICITEMContext) {
 	localctx = NewIdentifierWithTOPICITEMContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, TDTLParserRULE_identifierWithTOPICITEM)
+	p.EnterRule(localctx, 48, TDTLParserRULE_identifierWithTOPICITEM)
 
 	defer func() {
 		p.ExitRule()
@@ -3404,73 +4780,73 @@ func (p *TDTLParser) IdentifierWithTOPICITEM() (localctx IIdentifierWithTOPICITE
 		}
 	}()
 
-	p.SetState(216)
+	p.SetState(300)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(203)
+			p.SetState(287)
 			p.Match(TDTLParserPATHITEM)
 		}
 		{
-			p.SetState(204)
+			p.SetState(288)
 			p.Match(TDTLParserT__4)
 		}
 		{
-			p.SetState(205)
+			p.SetState(289)
 			p.Match(TDTLParserT__5)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(206)
+			p.SetState(290)
 			p.Match(TDTLParserPATHITEM)
 		}
 		{
-			p.SetState(207)
+			p.SetState(291)
 			p.Match(TDTLParserT__4)
 		}
 		{
-			p.SetState(208)
+			p.SetState(292)
 			p.Match(TDTLParserNUMBER)
 		}
 		{
-			p.SetState(209)
+			p.SetState(293)
 			p.Match(TDTLParserT__5)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(210)
+			p.SetState(294)
 			p.Match(TDTLParserPATHITEM)
 		}
 		{
-			p.SetState(211)
+			p.SetState(295)
 			p.Match(TDTLParserT__4)
 		}
 		{
-			p.SetState(212)
+			p.SetState(296)
 			p.Match(TDTLParserT__6)
 		}
 		{
-			p.SetState(213)
+			p.SetState(297)
 			p.Match(TDTLParserT__5)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(214)
+			p.SetState(298)
 			p.Match(TDTLParserPATHITEM)
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(215)
+			p.SetState(299)
 			p.Match(TDTLParserFLOAT)
 		}
 
@@ -3547,7 +4923,7 @@ func (s *IdentifierWithQualifierContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *TDTLParser) IdentifierWithQualifier() (localctx IIdentifierWithQualifierContext) {
 	localctx = NewIdentifierWithQualifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, TDTLParserRULE_identifierWithQualifier)
+	p.EnterRule(localctx, 50, TDTLParserRULE_identifierWithQualifier)
 
 	defer func() {
 		p.ExitRule()
@@ -3565,54 +4941,54 @@ func (p *TDTLParser) IdentifierWithQualifier() (localctx IIdentifierWithQualifie
 		}
 	}()
 
-	p.SetState(227)
+	p.SetState(311)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(218)
+			p.SetState(302)
 			p.Match(TDTLParserINDENTIFIER)
 		}
 		{
-			p.SetState(219)
+			p.SetState(303)
 			p.Match(TDTLParserT__7)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(220)
+			p.SetState(304)
 			p.Match(TDTLParserINDENTIFIER)
 		}
 		{
-			p.SetState(221)
+			p.SetState(305)
 			p.Match(TDTLParserT__4)
 		}
 		{
-			p.SetState(222)
+			p.SetState(306)
 			p.Match(TDTLParserNUMBER)
 		}
 		{
-			p.SetState(223)
+			p.SetState(307)
 			p.Match(TDTLParserT__5)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(224)
+			p.SetState(308)
 			p.Match(TDTLParserINDENTIFIER)
 		}
 		{
-			p.SetState(225)
+			p.SetState(309)
 			p.Match(TDTLParserT__8)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(226)
+			p.SetState(310)
 			p.Match(TDTLParserINDENTIFIER)
 		}
 
@@ -3623,7 +4999,7 @@ func (p *TDTLParser) IdentifierWithQualifier() (localctx IIdentifierWithQualifie
 
 func (p *TDTLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 9:
+	case 14:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -3638,12 +5014,15 @@ func (p *TDTLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *TDTLParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 5)
 
 	case 2:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 3:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	default:
@@ -296,6 +296,18 @@ func (p *printer) print(x Expr) {
 		}
 		p.indent--
 		p.printf("}")
+	case *InExpr:
+		p.printf("In [not: %v] {", x.not)
+		p.indent++
+		p.printf("\n")
+		p.print(x.exp)
+		for _, e := range x.list {
+			p.printf("\n")
+			p.print(e)
+		}
+		p.printf("\n")
+		p.indent--
+		p.printf("}")
 	case CaseListExpr:
 		p.printf("CaseListExpr {")
 		p.printf("}")
@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Param is a parameter of a function, Types lists the accepted types and nil accepts any.
+// A number parameter accepts Int, Float and Decimal, a container accepts JSON.
+type Param struct {
+	Name  string
+	Types []Type
+}
+
+// Function declares a function callable from a rule.
+type Function struct {
+	Name   string
+	Params []Param
+	// Optional is the number of trailing optional parameters.
+	Optional int
+	// Variadic repeats the last parameter.
+	Variadic bool
+	// Result is the type of the result, Undefined if it is only known at runtime.
+	Result Type
+	// Pure reports whether the result depends only on the arguments and the options of the rule.
+	Pure        bool
+	Description string
+	// Call or CallE implements the function, CallE reports a failure as an error
+	// which fails the field of the call.
+	Call  ContextFunc
+	CallE ContextFuncE
+
+	// resultOf refines Result by the types of the arguments.
+	resultOf func(args []Type) Type
+	// callWith replaces Call in a rule, it is called with the options of the rule.
+	callWith optionFunc
+	// callState implements a stateful function, its state is kept per call of a rule.
+	callState stateFunc
+	// udf implements a function created in TQL.
+	udf *userFunction
+}
+
+// Signature returns the declaration of fn for editors and docs,
+// e.g. "substr(s string, start number[, length number]) string".
+func (fn Function) Signature() string {
+	var sb strings.Builder
+	sb.WriteString(fn.Name + "(")
+	required := len(fn.Params) - fn.Optional
+	for i, p := range fn.Params {
+		if i >= required {
+			sb.WriteString("[")
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(p.Name + " ")
+		if fn.Variadic && i == len(fn.Params)-1 {
+			sb.WriteString("...")
+		}
+		sb.WriteString(signatureTypes(p.Types))
+	}
+	sb.WriteString(strings.Repeat("]", fn.Optional) + ") ")
+	sb.WriteString(signatureTypes([]Type{fn.Result}))
+	return sb.String()
+}
+
+func signatureTypes(types []Type) string {
+	if len(types) == 0 || (len(types) == 1 && types[0] == Undefined) {
+		return "any"
+	}
+	names := make([]string, len(types))
+	for i, typ := range types {
+		names[i] = strings.ToLower(typ.String())
+	}
+	return strings.Join(names, "|")
+}
+
+// resultType returns the type of a call of fn with arguments of types args.
+func (fn *Function) resultType(args []Type) Type {
+	if fn.resultOf != nil {
+		return fn.resultOf(args)
+	}
+	return fn.Result
+}
+
+// call calls fn as expr in the evaluation context ctx of a rule with the options o.
+func (fn *Function) call(ctx Context, o *options, expr *CallExpr, args []Node) Node {
+	switch {
+	case fn.callState != nil:
+		return fn.callState(newCallState(ctx, o, expr), args...)
+	case fn.udf != nil:
+		return fn.udf.call(ctx, o, expr, args)
+	case fn.callWith != nil:
+		return fn.callWith(o, args...)
+	case fn.CallE != nil:
+		ret, err := fn.CallE(ctx, args...)
+		if err != nil {
+			return errorNode(err)
+		}
+		if ret == nil {
+			return UNDEFINED_RESULT
+		}
+		return ret
+	}
+	return fn.Call(args...)
+}
+
+var (
+	identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// functionName is an identifier in a namespace or not, e.g. acme.decode.
+	functionName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+)
+
+// FunctionRegistry is a catalog of functions with their signatures and of the
+// function packages which rules import, it is safe for concurrent use.
+type FunctionRegistry struct {
+	mu    sync.RWMutex
+	funcs map[string]*Function
+	// packages by name and version.
+	packages map[string]map[string]FunctionPackage
+}
+
+// NewFunctionRegistry returns an empty registry.
+func NewFunctionRegistry() *FunctionRegistry {
+	return &FunctionRegistry{funcs: map[string]*Function{}, packages: map[string]map[string]FunctionPackage{}}
+}
+
+// Register adds or replaces the function fn.Name, a dotted name such as
+// acme.decode declares it in a namespace. A built-in function is not replaced,
+// as the rules would still call the built-in one.
+func (r *FunctionRegistry) Register(fn Function) error {
+	if !functionName.MatchString(fn.Name) {
+		return fmt.Errorf("tdtl: invalid function name %q", fn.Name)
+	}
+	if r != Builtins && Builtins.lookup(fn.Name) != nil {
+		return fmt.Errorf("tdtl: function %s is built in", fn.Name)
+	}
+	return r.register(fn)
+}
+
+// register adds or replaces the function fn, its name is valid.
+func (r *FunctionRegistry) register(fn Function) error {
+	if err := validateFunction(fn); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.funcs[fn.Name] = &fn
+	return nil
+}
+
+func validateFunction(fn Function) error {
+	if fn.Call == nil && fn.CallE == nil && fn.callState == nil && fn.udf == nil {
+		return fmt.Errorf("tdtl: function %s has no implementation", fn.Name)
+	}
+	if fn.Optional < 0 || fn.Optional > len(fn.Params) || (fn.Variadic && len(fn.Params) == 0) {
+		return fmt.Errorf("tdtl: function %s has invalid parameters", fn.Name)
+	}
+	return nil
+}
+
+// RegisterPackage adds or replaces the package pkg.Name() at pkg.Version(), its functions
+// are callable from the rules which import it. Several versions of a package are kept.
+func (r *FunctionRegistry) RegisterPackage(pkg FunctionPackage) error {
+	if !identifier.MatchString(pkg.Name()) {
+		return fmt.Errorf("tdtl: invalid package name %q", pkg.Name())
+	}
+	if pkg.Version() == "" {
+		return fmt.Errorf("tdtl: package %s has no version", pkg.Name())
+	}
+	for _, fn := range pkg.Functions() {
+		if !identifier.MatchString(fn.Name) {
+			return fmt.Errorf("tdtl: invalid function name %q in package %s", fn.Name, pkg.Name())
+		}
+		if err := validateFunction(fn); err != nil {
+			return err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.packages[pkg.Name()] == nil {
+		r.packages[pkg.Name()] = map[string]FunctionPackage{}
+	}
+	r.packages[pkg.Name()][pkg.Version()] = pkg
+	return nil
+}
+
+// Package returns the newest version of the package name which matches version,
+// e.g. 1.2 matches 1.2.0 and 1.2.3, an empty version matches any.
+func (r *FunctionRegistry) Package(name, version string) (FunctionPackage, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var newest FunctionPackage
+	for v, pkg := range r.packages[name] {
+		if matchVersion(v, version) && (newest == nil || compareVersions(v, newest.Version()) > 0) {
+			newest = pkg
+		}
+	}
+	return newest, newest != nil
+}
+
+// versions returns the versions of the package name from the oldest.
+func (r *FunctionRegistry) versions(name string) []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ret := make([]string, 0, len(r.packages[name]))
+	for v := range r.packages[name] {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool { return compareVersions(ret[i], ret[j]) < 0 })
+	return ret
+}
+
+// Lookup returns the function name.
+func (r *FunctionRegistry) Lookup(name string) (Function, bool) {
+	if fn := r.lookup(name); fn != nil {
+		return *fn, true
+	}
+	return Function{}, false
+}
+
+func (r *FunctionRegistry) lookup(name string) *Function {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.funcs[name]
+}
+
+// Functions returns the catalog of the functions sorted by name.
+func (r *FunctionRegistry) Functions() []Function {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ret := make([]Function, 0, len(r.funcs))
+	for _, fn := range r.funcs {
+		ret = append(ret, *fn)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctionRegistry(t *testing.T) {
+	double := func(args ...Node) Node {
+		if len(args) != 1 {
+			return UNDEFINED_RESULT
+		}
+		x, ok := floatArg(args[0])
+		if !ok {
+			return UNDEFINED_RESULT
+		}
+		return FloatNode(2 * x)
+	}
+	r := NewFunctionRegistry()
+	assert.Error(t, r.Register(Function{Name: "bad-name", Call: double}))
+	assert.Error(t, r.Register(Function{Name: "double"}))
+	assert.Error(t, r.Register(Function{Name: "double", Optional: 1, Call: double}))
+	assert.NoError(t, r.Register(Function{Name: "double", Params: []Param{{"x", numberTypes}},
+		Result: Float, Pure: true, Description: "Doubles x.", Call: double}))
+	assert.NoError(t, r.Register(Function{Name: "answer", Result: Int, Call: func(args ...Node) Node { return IntNode(42) }}))
+	// a built-in function would still be called by the rules, its name is rejected.
+	assert.EqualError(t, r.Register(Function{Name: "convert", Params: []Param{{"s", stringTypes}}, Call: double}),
+		"tdtl: function convert is built in")
+
+	fn, ok := r.Lookup("double")
+	assert.True(t, ok)
+	assert.Equal(t, "double(x number) float", fn.Signature())
+	_, ok = r.Lookup("triple")
+	assert.False(t, ok)
+
+	var names []string
+	for _, fn := range r.Functions() {
+		names = append(names, fn.Name)
+	}
+	assert.Equal(t, []string{"answer", "double"}, names)
+}
+
+func TestBuiltinsSignature(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"substr", "substr(s string, start number[, length number]) string"},
+		{"parse_time", "parse_time(s string[, layout string[, tz string]]) timestamp"},
+		{"concat", "concat([x ...any]) string"},
+		{"json_delete", "json_delete(doc object|array|string, path ...string) json"},
+		{"json_get", "json_get(doc object|array|string, path string) any"},
+		{"now", "now() timestamp"},
+	}
+	for _, tt := range tests {
+		fn, ok := Builtins.Lookup(tt.name)
+		assert.True(t, ok, tt.name)
+		assert.Equal(t, tt.want, fn.Signature())
+	}
+	for _, fn := range Builtins.Functions() {
+		assert.NotEmpty(t, fn.Description, fn.Name)
+	}
+	now, _ := Builtins.Lookup("now")
+	assert.False(t, now.Pure)
+}
+
+func TestParseFunc(t *testing.T) {
+	expr, err := Parse(`insert into target select upper(trim(dev.name)) as name,
+		length(dev.name) > 1 as long, CASE dev.kind WHEN lower('A') THEN abs(dev.x) ELSE sign(dev.x) as k`)
+	assert.NoError(t, err)
+	var keys []string
+	for _, call := range ParseFunc(expr) {
+		keys = append(keys, call.key)
+	}
+	assert.Equal(t, []string{"upper", "trim", "length", "lower", "abs", "sign"}, keys)
+}
+
+func TestNewTDTLCheckCalls(t *testing.T) {
+	ext := map[string]ContextFunc{"legacy": func(args ...Node) Node { return IntNode(1) }}
+	funcs := NewFunctionRegistry()
+	assert.NoError(t, funcs.Register(Function{Name: "double", Params: []Param{{"x", numberTypes}}, Result: Float,
+		Call: func(args ...Node) Node { return FloatNode(2 * float64(args[0].To(Float).(FloatNode))) }}))
+
+	tests := []struct {
+		sql string
+		err string
+	}{
+		{`insert into target select upper(dev.name) as a, legacy(dev.x, 1, 2) as b`, ""},
+		{`insert into target select nosuch(1) as a`, "[1:26]nosuch(1): undefined function nosuch"},
+		{`insert into target select math.round(dev.x) as a`, "[1:26]math.round(dev.x): undefined function math.round"},
+		{`create function f(x) as nosuch(x) insert into target select f(1) as a`, "[1:24]nosuch(x): undefined function nosuch"},
+		{`insert into target select substr(dev.name) as a`, "[1:26]substr(dev.name): substr expects 2 to 3 arguments, found 1"},
+		{`insert into target select round(dev.x, 'two') as a`, "[0:0]\"two\": argument 2 of round must be Number, found String"},
+		{`insert into target select upper(sqrt(dev.x)) as a`, "[1:32]sqrt(dev.x): argument 1 of upper must be String, found Float"},
+		{`insert into target select double('x') as a`, "[0:0]\"x\": argument 1 of double must be Number, found String"},
+		{`insert into target select starts_with(dev.name) as a, double(1, 2) > 1 as b`,
+			"[1:26]starts_with(dev.name): starts_with expects 2 arguments, found 1\n" +
+				"[1:54]double(1, 2): double expects 1 arguments, found 2"},
+	}
+	for _, tt := range tests {
+		_, err := NewTDTL(tt.sql, ext, WithFunctions(funcs))
+		if tt.err == "" {
+			assert.NoError(t, err, tt.sql)
+			continue
+		}
+		assert.EqualError(t, err, tt.err, tt.sql)
+	}
+
+	_, err := NewExpr(`lower(1, 2)`, nil)
+	assert.EqualError(t, err, "[1:0]lower(1, 2): lower expects 1 arguments, found 2")
+
+	// foo may be resolved by the context at runtime.
+	_, err = NewTDTL(`insert into target select foo(dev.x) as a`, nil, WithRuntimeFunctions())
+	assert.NoError(t, err)
+
+	tql, err := NewTDTL(`insert into target select double(dev.x) as d`, nil, WithFunctions(funcs))
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{"dev.x": IntNode(21)})
+	assert.NoError(t, err)
+	assert.Equal(t, "42", ret["d"].String())
+}
+
+func TestExtFuncOverride(t *testing.T) {
+	ext := map[string]ContextFunc{
+		"length":  func(args ...Node) Node { return IntNode(42) },
+		"convert": func(args ...Node) Node { return StringNode("team:" + args[0].String()) },
+	}
+	tql, err := NewTDTL(`insert into target select length(entity.name) as n, convert(entity.x) as c,
+		upper(entity.name) as u`, ext)
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{"entity.name": StringNode("abc"), "entity.x": IntNode(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, IntNode(42), ret["n"])
+	assert.Equal(t, "team:1", ret["c"].String())
+	assert.Equal(t, "ABC", ret["u"].String())
+
+	// a function which only the context resolves at runtime.
+	tql, err = NewTDTL(`insert into target select myfn(entity.x) as y`, nil, WithRuntimeFunctions())
+	assert.NoError(t, err)
+	ctx := MutilContext{NewMapContext(map[string]Node{"entity.x": IntNode(1)},
+		map[string]ContextFunc{"myfn": func(args ...Node) Node { return IntNode(7) }})}
+	assert.Equal(t, `{"y":7}`, EvalRuleQL(ctx, tql.(*tdtl).expr()).String())
+}
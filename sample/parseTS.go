@@ -32,23 +32,13 @@ var input = `{
 
 func main() {
 	tqlString := `
-		insert into entity3 
-		select 
-			json(telemetryV1(entity1.input), 'values') as property1, 
-			entity2.property2.name as property2, 
+		insert into entity3
+		select
+			json_get(telemetryV1(entity1.input), 'values') as property1,
+			entity2.property2.name as property2,
 			entity1.property1 + entity2.property3 as property3`
 
 	tqlInst, err := tdtl.NewTDTL(tqlString, map[string]tdtl.ContextFunc{
-		"json": func(args ...tdtl.Node) tdtl.Node {
-			if len(args) != 2 {
-				return tdtl.NULL_RESULT
-			}
-			jsonRaw := args[0].String()
-			path := args[1].String()
-
-			cc := New(jsonRaw)
-			return tdtl.JSONNode(string(cc.Get(path).GetRaw()))
-		},
 		"telemetryV1": func(args ...tdtl.Node) tdtl.Node {
 			if len(args) != 1 {
 				return tdtl.NULL_RESULT
@@ -58,17 +48,17 @@ func main() {
 				return tdtl.NULL_RESULT
 			}
 
-			cc := New(payload.String())
+			cc := tdtl.New(string(payload))
 			ts := cc.Get("ts")
 			vals := cc.Get("values")
-			vals.Map(func(key []byte, value []byte) []byte {
-				val := New("{}")
-				val.Set("timestamp", ts.GetRaw())
+			vals.Map(func(key []byte, value *tdtl.Collect) tdtl.Node {
+				val := tdtl.New("{}")
+				val.Set("timestamp", ts)
 				val.Set("value", value)
-				return val.GetRaw()
+				return val
 			})
-			cc.Set("values", vals.GetRaw())
-			return tdtl.JSONNode(string(cc.GetRaw()))
+			cc.Set("values", vals)
+			return cc
 		},
 	})
 	if nil != err {
@@ -77,9 +67,7 @@ func main() {
 
 	fmt.Println("target: ", tqlInst.Target())
 	fmt.Println("sources: ", tqlInst.Entities())
-	for _, tentacle := range tqlInst.Tentacles() {
-		fmt.Println("tentacle: ", tentacle)
-	}
+	fmt.Println("fields: ", tqlInst.Fields())
 
 	result, err := tqlInst.Exec(map[string]tdtl.Node{
 		"entity1.input":          tdtl.StringNode(input),
@@ -87,7 +75,9 @@ func main() {
 		"entity2.property2.name": tdtl.StringNode("123"),
 		"entity2.property3":      tdtl.StringNode("g123"),
 	})
+	if nil != err {
+		panic(err)
+	}
 
 	fmt.Println(result)
-
 }
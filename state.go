@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+// StateStore keeps the values of the stateful functions of rules across Exec calls,
+// such as the previous value of delta(x). The keys name the rule, the dimension
+// and the call. An implementation must be safe for concurrent use.
+type StateStore interface {
+	// Get returns the value at key, ok is false if there is none.
+	Get(key string) (value Node, ok bool)
+	// Swap stores value at key and returns the previous value in a single step.
+	Swap(key string, value Node) (old Node, ok bool)
+}
+
+const (
+	// DefaultStateSize is the number of values kept by the memory store of a rule.
+	DefaultStateSize = 1 << 16
+	// DefaultStateTTL is how long the memory store of a rule keeps a value which is not updated.
+	DefaultStateTTL = 24 * time.Hour
+)
+
+// NewMemoryStateStore returns a StateStore in memory which keeps at most size values
+// for ttl after their last update, the least recently updated value is evicted first.
+// A size or ttl of 0 is unlimited.
+func NewMemoryStateStore(size int, ttl time.Duration) StateStore {
+	return &memoryStateStore{
+		size:   size,
+		ttl:    ttl,
+		now:    time.Now,
+		values: map[string]*list.Element{},
+		order:  list.New(),
+	}
+}
+
+type memoryStateStore struct {
+	mu   sync.Mutex
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+	// values are the elements of order, the most recently updated first.
+	values map[string]*list.Element
+	order  *list.List
+}
+
+type stateEntry struct {
+	key     string
+	value   Node
+	updated time.Time
+}
+
+func (s *memoryStateStore) Get(key string) (Node, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+	if elem, ok := s.values[key]; ok {
+		return elem.Value.(*stateEntry).value, true
+	}
+	return nil, false
+}
+
+func (s *memoryStateStore) Swap(key string, value Node) (Node, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+	if elem, ok := s.values[key]; ok {
+		entry := elem.Value.(*stateEntry)
+		old := entry.value
+		entry.value, entry.updated = value, s.now()
+		s.order.MoveToFront(elem)
+		return old, true
+	}
+	s.values[key] = s.order.PushFront(&stateEntry{key: key, value: value, updated: s.now()})
+	if s.size > 0 && s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+	return nil, false
+}
+
+// expire removes the values not updated within the ttl, they are the last of order.
+func (s *memoryStateStore) expire() {
+	if s.ttl <= 0 {
+		return
+	}
+	now := s.now()
+	for elem := s.order.Back(); elem != nil; elem = s.order.Back() {
+		if now.Sub(elem.Value.(*stateEntry).updated) <= s.ttl {
+			return
+		}
+		s.remove(elem)
+	}
+}
+
+func (s *memoryStateStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.values, elem.Value.(*stateEntry).key)
+}
+
+// callState is the state of a call of a stateful function in a rule.
+type callState struct {
+	store StateStore
+	key   string
+	// options of the rule which calls the function.
+	options *options
+}
+
+// stateFunc is a built-in function which keeps a value across the Exec calls of a rule.
+type stateFunc func(s *callState, args ...Node) Node
+
+// newCallState returns the state of the call expr in ctx, it is kept per rule,
+// per dimension of the input and per call. A call in the body of a user function
+// is kept per call of the function. Without a store, as outside a rule, the state
+// lasts for the call only.
+func newCallState(ctx Context, o *options, expr *CallExpr) *callState {
+	store := o.state
+	if store == nil {
+		store = NewMemoryStateStore(0, 0)
+	}
+	dimension := ""
+	if len(o.dimensions) > 0 {
+		dimension = evalDimensions(ctx, o.dimensions).String()
+	}
+	return &callState{
+		store:   store,
+		key:     fmt.Sprintf("%s/%s/%s", o.rule, dimension, callSites(ctx, expr)),
+		options: o,
+	}
+}
+
+// callSites names the call expr and the calls of the user functions it is evaluated in,
+// the outermost first.
+func callSites(ctx Context, expr *CallExpr) string {
+	sites := []string{fmt.Sprintf("[%s]%s", expr.pos, exprString(expr))}
+	for c := ctx; c != nil; {
+		switch x := c.(type) {
+		case modeContext:
+			c = x.Context
+		case *udfContext:
+			if x.site != nil {
+				sites = append([]string{fmt.Sprintf("[%s]%s", x.site.pos, exprString(x.site))}, sites...)
+			}
+			c = x.Context
+		default:
+			c = nil
+		}
+	}
+	return strings.Join(sites, "/")
+}
+
+// observe stores x as the latest value of the call and returns the previous one,
+// an undefined x is not stored.
+func (s *callState) observe(x Node) (Node, bool) {
+	if x.Type() == Undefined || x.Type() == Null {
+		return nil, false
+	}
+	return s.store.Swap(s.key, x)
+}
+
+// prevFunc prev(x) returns the value of x in the previous Exec of the rule,
+// undefined the first time.
+var prevFunc = func(s *callState, args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined || args[0].Type() == Null {
+		if old, ok := s.store.Get(s.key); ok {
+			return old
+		}
+		return UNDEFINED_RESULT
+	}
+	if old, ok := s.store.Swap(s.key, args[0]); ok {
+		return old
+	}
+	return UNDEFINED_RESULT
+}
+
+// deltaFunc delta(x) returns x minus its previous value, e.g. the consumption
+// of an energy counter, undefined the first time.
+var deltaFunc = func(s *callState, args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	x, ok := numberArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	old, ok := s.observe(x)
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	return evalBinary(s.options, parser.TDTLParserSUB, x, old)
+}
+
+// rateFunc rate(counter[, ts]) returns the increase of counter per second since its
+// previous value, a decrease is a reset of the counter which restarts from 0.
+// The time of the value is ts, the clock of the rule by default.
+var rateFunc = func(s *callState, args ...Node) Node {
+	if len(args) != 1 && len(args) != 2 {
+		return UNDEFINED_RESULT
+	}
+	x, ok := floatArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	t := s.options.clock()
+	if len(args) == 2 {
+		if t, ok = timeArg(args[1]); !ok {
+			return UNDEFINED_RESULT
+		}
+	}
+	sample := New("{}")
+	sample.Set("v", FloatNode(x))
+	sample.Set("t", IntNode(t.UnixNano()))
+	old, ok := s.observe(sample.Node())
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	prev, ok := docArg(old)
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	v, ok1 := floatArg(prev.Get("v").Node())
+	ns, ok2 := prev.Get("t").Node().(IntNode)
+	elapsed := time.Duration(t.UnixNano() - int64(ns)).Seconds()
+	if !ok1 || !ok2 || elapsed <= 0 {
+		return UNDEFINED_RESULT
+	}
+	increase := x - v
+	if increase < 0 {
+		increase = x
+	}
+	return FloatNode(increase / elapsed)
+}
+
+// changedFunc changed(x) reports whether x differs from its previous value,
+// the first value of x is a change.
+var changedFunc = func(s *callState, args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined || args[0].Type() == Null {
+		return UNDEFINED_RESULT
+	}
+	old, ok := s.observe(args[0])
+	return BoolNode(!ok || !Equal(old, args[0]))
+}
+
+// counterResetFunc counter_reset(x) reports whether the counter x decreased
+// since its previous value, e.g. after the restart of a device.
+var counterResetFunc = func(s *callState, args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	x, ok := floatArg(args[0])
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	old, ok := s.observe(FloatNode(x))
+	if !ok {
+		return BoolNode(false)
+	}
+	v, _ := floatArg(old)
+	return BoolNode(x < v)
+}
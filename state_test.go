@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateFunc(t *testing.T) {
+	now := time.Date(2021, 12, 1, 8, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	tql, err := NewTDTL(`insert into target select delta(dev.energy) as consumption, rate(dev.energy) as power,
+		prev(dev.status) as last, changed(dev.status) as changed, counter_reset(dev.energy) as reset`,
+		nil, WithClock(clock))
+	assert.NoError(t, err)
+
+	exec := func(energy int64, status string) map[string]Node {
+		ret, err := tql.Exec(map[string]Node{"dev.energy": IntNode(energy), "dev.status": StringNode(status)})
+		assert.NoError(t, err)
+		return ret
+	}
+
+	ret := exec(100, "on")
+	assert.Equal(t, Undefined, ret["consumption"].Type())
+	assert.Equal(t, Undefined, ret["power"].Type())
+	assert.Equal(t, Undefined, ret["last"].Type())
+	assert.Equal(t, BoolNode(true), ret["changed"])
+	assert.Equal(t, BoolNode(false), ret["reset"])
+
+	now = now.Add(10 * time.Second)
+	ret = exec(150, "on")
+	assert.Equal(t, IntNode(50), ret["consumption"])
+	assert.Equal(t, "5", ret["power"].String())
+	assert.Equal(t, StringNode("on"), ret["last"])
+	assert.Equal(t, BoolNode(false), ret["changed"])
+	assert.Equal(t, BoolNode(false), ret["reset"])
+
+	now = now.Add(5 * time.Second)
+	ret = exec(20, "off")
+	assert.Equal(t, IntNode(-130), ret["consumption"])
+	assert.Equal(t, "4", ret["power"].String())
+	assert.Equal(t, StringNode("on"), ret["last"])
+	assert.Equal(t, BoolNode(true), ret["changed"])
+	assert.Equal(t, BoolNode(true), ret["reset"])
+
+	// an undefined value keeps the previous one.
+	ret, err = tql.Exec(map[string]Node{"dev.energy": IntNode(30)})
+	assert.NoError(t, err)
+	assert.Equal(t, StringNode("off"), ret["last"])
+	assert.Equal(t, Undefined, ret["changed"].Type())
+
+	// each rule keeps its own state.
+	other, err := NewTDTL(`insert into target select delta(dev.energy) as consumption`, nil)
+	assert.NoError(t, err)
+	ret, err = other.Exec(map[string]Node{"dev.energy": IntNode(40)})
+	assert.NoError(t, err)
+	assert.Equal(t, Undefined, ret["consumption"].Type())
+}
+
+func TestStateFuncDimensions(t *testing.T) {
+	store := NewMemoryStateStore(0, 0)
+	newRule := func() TDTL {
+		tql, err := NewTDTL(`insert into target select delta(dev.energy) as consumption`, nil,
+			WithStateStore(store, "energy"), WithDimensions("dev.id"))
+		assert.NoError(t, err)
+		return tql
+	}
+	exec := func(tql TDTL, id string, energy int64) Node {
+		ret, err := tql.Exec(map[string]Node{"dev.id": StringNode(id), "dev.energy": IntNode(energy)})
+		assert.NoError(t, err)
+		return ret["consumption"]
+	}
+
+	tql := newRule()
+	assert.Equal(t, Undefined, exec(tql, "meter-1", 100).Type())
+	assert.Equal(t, Undefined, exec(tql, "meter-2", 500).Type())
+	assert.Equal(t, IntNode(10), exec(tql, "meter-1", 110))
+	assert.Equal(t, IntNode(25), exec(tql, "meter-2", 525))
+
+	// the state is shared through the store, e.g. after a restart of the rule.
+	tql = newRule()
+	assert.Equal(t, IntNode(5), exec(tql, "meter-1", 115))
+
+	_, ok := store.Get("energy/meter-1/[1:26]delta(dev.energy)")
+	assert.True(t, ok)
+}
+
+func TestStateFuncCallSites(t *testing.T) {
+	tql, err := NewTDTL(`CREATE FUNCTION usage(x) AS delta(x)
+		INSERT INTO target SELECT usage(dev.a) as a, usage(dev.b) as b`, nil)
+	assert.NoError(t, err)
+	exec := func(a, b int64) map[string]Node {
+		ret, err := tql.Exec(map[string]Node{"dev.a": IntNode(a), "dev.b": IntNode(b)})
+		assert.NoError(t, err)
+		return ret
+	}
+	exec(100, 1000)
+	ret := exec(110, 1200)
+	assert.Equal(t, IntNode(10), ret["a"])
+	assert.Equal(t, IntNode(200), ret["b"])
+}
+
+func TestStateFuncOutsideRule(t *testing.T) {
+	expr, err := ParseExpr(`prev(dev.status)`)
+	assert.NoError(t, err)
+	for _, status := range []string{"on", "off"} {
+		ctx := NewMapContext(map[string]Node{"dev.status": StringNode(status)}, nil)
+		assert.Equal(t, Undefined, EvalRuleQL(ctx, expr).Type())
+		assert.Equal(t, Undefined, DefaultValue.Call(&CallExpr{key: "prev"}, []Node{StringNode(status)}).Type())
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	now := time.Date(2021, 12, 1, 8, 0, 0, 0, time.UTC)
+	store := NewMemoryStateStore(2, time.Minute)
+	store.(*memoryStateStore).now = func() time.Time { return now }
+
+	store.Swap("a", IntNode(1))
+	store.Swap("b", IntNode(2))
+	store.Swap("a", IntNode(3))
+	store.Swap("c", IntNode(4))
+	_, ok := store.Get("b")
+	assert.False(t, ok, "the least recently updated value is evicted")
+	value, ok := store.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, IntNode(3), value)
+
+	now = now.Add(30 * time.Second)
+	store.Swap("c", IntNode(5))
+	now = now.Add(45 * time.Second)
+	_, ok = store.Get("a")
+	assert.False(t, ok, "a value not updated within the ttl expires")
+	old, ok := store.Swap("c", IntNode(6))
+	assert.True(t, ok)
+	assert.Equal(t, IntNode(5), old)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+// EvalMode decides how binary operators and functions treat operands of different types.
+type EvalMode int
+
+const (
+	// LenientMode converts operands implicitly, e.g. '10' > 9 compares numbers
+	// and 'N/A' + 1 is undefined.
+	LenientMode EvalMode = iota
+	// StrictMode rejects operands of different types with ErrTypeMismatch,
+	// numbers of any kind are still compatible with each other. The arguments
+	// of a function must be of the types of its parameters.
+	StrictMode
+)
+
+var opNames = map[int]string{
+	parser.TDTLParserMUL: "*",
+	parser.TDTLParserDIV: "/",
+	parser.TDTLParserMOD: "%",
+	parser.TDTLParserADD: "+",
+	parser.TDTLParserSUB: "-",
+	parser.TDTLParserEQ:  "=",
+	parser.TDTLParserNE:  "<>",
+	parser.TDTLParserGT:  ">",
+	parser.TDTLParserGTE: ">=",
+	parser.TDTLParserLT:  "<",
+	parser.TDTLParserLTE: "<=",
+	parser.TDTLParserAND: "AND",
+	parser.TDTLParserOR:  "OR",
+	parser.TDTLParserNOT: "NOT",
+}
+
+// checkStrict returns ErrTypeMismatch if op on lhs and rhs needs an implicit conversion.
+// Undefined and null operands are left to the evaluator.
+func checkStrict(op int, lhs, rhs Node) error {
+	lhs, rhs = structuralNode(lhs), structuralNode(rhs)
+	lk, rk := compareKind(lhs), compareKind(rhs)
+	if lk == Undefined || lk == Null || rk == Undefined || rk == Null {
+		return nil
+	}
+
+	switch {
+	case isLogicOP(op):
+		if lk == Bool && rk == Bool {
+			return nil
+		}
+	case isArithmeticOP(op):
+		if lk == Array || rk == Array {
+			return checkStrictElements(op, lhs, rhs)
+		}
+		if strictArithmetic(op, lk, rk) {
+			return nil
+		}
+	case op == parser.TDTLLexerEQ || op == parser.TDTLLexerNE:
+		// equality of objects and arrays is structural, it never converts.
+		if lk == rk || lk == Array || lk == Object || rk == Array || rk == Object {
+			return nil
+		}
+	default:
+		if lk == rk {
+			return nil
+		}
+	}
+	return typeMismatch(op, lhs, rhs)
+}
+
+// strictArithmetic reports whether op is defined on the kinds without conversion.
+func strictArithmetic(op int, lk, rk Type) bool {
+	switch {
+	case lk == Number && rk == Number:
+		return true
+	case lk == String && rk == String, lk == Bytes && rk == Bytes:
+		return op == parser.TDTLParserADD
+	case lk == Duration && rk == Duration:
+		return op != parser.TDTLParserMOD
+	case lk == Timestamp && rk == Timestamp:
+		return op == parser.TDTLParserSUB
+	case lk == Timestamp && rk == Duration:
+		return op == parser.TDTLParserADD || op == parser.TDTLParserSUB
+	case lk == Duration && rk == Timestamp:
+		return op == parser.TDTLParserADD
+	case lk == Duration && rk == Number:
+		return op == parser.TDTLParserMUL || op == parser.TDTLParserDIV
+	case lk == Number && rk == Duration:
+		return op == parser.TDTLParserMUL
+	}
+	return false
+}
+
+// checkStrictElements checks the element pairs of a broadcast operation.
+func checkStrictElements(op int, lhs, rhs Node) error {
+	left, lok := arrayElements(lhs)
+	right, rok := arrayElements(rhs)
+	switch {
+	case lok && rok:
+		if len(left) != len(right) {
+			// reported by the broadcast itself.
+			return nil
+		}
+	case lok:
+		right = repeatNode(rhs, len(left))
+	case rok:
+		left = repeatNode(lhs, len(right))
+	}
+	for i := range left {
+		if err := checkStrict(op, left[i], right[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStrictArgs returns ErrTypeMismatch if an argument of fn is not of a kind
+// its parameter declares, e.g. 'N/A' for a number. A parameter of any type,
+// as those of a created function, takes every argument.
+func checkStrictArgs(fn *Function, args []Node) error {
+	if len(fn.Params) == 0 {
+		return nil
+	}
+	for i, arg := range args {
+		params := fn.Params[len(fn.Params)-1].Types
+		if i < len(fn.Params) {
+			params = fn.Params[i].Types
+		}
+		kind := compareKind(structuralNode(arg))
+		if params == nil || kind == Undefined || kind == Null || acceptsKind(params, kind) {
+			continue
+		}
+		return fmt.Errorf("%w: argument %d of %s is %s, not %s", ErrTypeMismatch, i+1, fn.Name, arg.Type(), kindNames(params))
+	}
+	return nil
+}
+
+func typeMismatch(op int, lhs, rhs Node) error {
+	return fmt.Errorf("%w: %s %s %s", ErrTypeMismatch, lhs.Type(), opNames[op], rhs.Type())
+}
+
+// modeContext carries the options of a rule through evaluation.
+type modeContext struct {
+	Context
+	options *options
+}
+
+// optionsOf returns the options carried by ctx, the default options if there are none.
+func optionsOf(ctx Context) *options {
+	switch ctx := ctx.(type) {
+	case modeContext:
+		return ctx.options
+	case MutilContext:
+		for _, c := range ctx {
+			if o := optionsOf(c); o != defaultOptions {
+				return o
+			}
+		}
+	}
+	return defaultOptions
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictEval(t *testing.T) {
+	input := map[string]Node{
+		"dev.temp":     StringNode("N/A"),
+		"dev.level":    StringNode("10"),
+		"dev.value":    IntNode(10),
+		"dev.ratio":    FloatNode(0.5),
+		"dev.on":       BoolNode(true),
+		"dev.name":     StringNode("lamp"),
+		"dev.channels": New(`[1, 2, "x"]`),
+		"dev.numbers":  New(`[1, 2, 3]`),
+	}
+	tests := []struct {
+		expr    string
+		lenient string
+		strict  string
+	}{
+		{`dev.temp > 30`, "false", ""},
+		{`dev.level > 9`, "true", ""},
+		{`dev.level + 1`, "101", ""},
+		{`dev.value + dev.ratio`, "10.5", "10.5"},
+		{`dev.value * decimal('1.5')`, "15.0", "15.0"},
+		{`dev.name + '_1'`, "lamp_1", "lamp_1"},
+		{`dev.level = '10'`, "true", "true"},
+		{`dev.on = 'true'`, "true", ""},
+		{`dev.level - '1'`, "9", ""},
+		{`dev.numbers * 2`, "[2,4,6]", "[2,4,6]"},
+		{`dev.channels * 2`, "[2,4,null]", ""},
+		{`dev.numbers = dev.channels`, "false", "false"},
+		{`INTERVAL '1m' * 2`, "2m0s", "2m0s"},
+		{`INTERVAL '1m' = '60s'`, "true", ""},
+		{`dev.missing > 1`, "false", "false"},
+		{`(dev.temp > 30) + 1`, "", ""},
+		{`dev.numbers > 1`, "", ""},
+		{`dev.numbers < dev.channels`, "true", "true"},
+		{`abs(dev.level)`, "10", ""},
+		{`round(dev.ratio)`, "1", "1"},
+		{`upper(dev.value)`, "10", ""},
+		{`upper(dev.name)`, "LAMP", "LAMP"},
+		{`to_unixtime(dev.level)`, "10", "10"},
+		{`sqrt(dev.missing)`, "", ""},
+		{`join(dev.numbers, '-')`, "1-2-3", "1-2-3"},
+	}
+	for _, tt := range tests {
+		lenient, err := NewExpr(tt.expr, nil)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.lenient, lenient.Eval(input).String(), tt.expr)
+
+		strict, err := NewExpr(tt.expr, nil, WithEvalMode(StrictMode))
+		assert.NoError(t, err, tt.expr)
+		ret := strict.Eval(input)
+		assert.Equal(t, tt.strict, ret.String(), tt.expr)
+		if tt.strict == "" && tt.lenient != "" {
+			assert.True(t, errors.Is(ret.Error(), ErrTypeMismatch), "%s: %v", tt.expr, ret.Error())
+		}
+	}
+
+	// ordering an array and a number is undefined, strict mode reports it.
+	strict, err := NewExpr(`dev.numbers > 1`, nil, WithEvalMode(StrictMode))
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(strict.Eval(input).Error(), ErrTypeMismatch))
+}
+
+func TestStrictExec(t *testing.T) {
+	tqlString := `insert into target select dev.temp > 30 as alarm, dev.name as name`
+	input := map[string]Node{
+		"dev.temp": StringNode("N/A"),
+		"dev.name": StringNode("lamp"),
+	}
+
+	tqlInst, err := NewTDTL(tqlString, nil)
+	assert.NoError(t, err)
+	ret, err := tqlInst.Exec(input)
+	assert.NoError(t, err)
+	assert.Equal(t, "false", ret["alarm"].String())
+
+	tqlInst, err = NewTDTL(tqlString, nil, WithEvalMode(StrictMode))
+	assert.NoError(t, err)
+	ret, err = tqlInst.Exec(input)
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	assert.EqualError(t, err, "alarm: [1:26]dev.temp > 30: type mismatch: String > Int")
+	assert.Equal(t, Undefined, ret["alarm"].Type())
+	assert.Equal(t, "lamp", ret["name"].String())
+
+	tqlInst, err = NewTDTL(`insert into target select abs(dev.temp) as temp`, nil, WithEvalMode(StrictMode))
+	assert.NoError(t, err)
+	_, err = tqlInst.Exec(input)
+	assert.EqualError(t, err, "temp: [1:26]abs(dev.temp): type mismatch: argument 1 of abs is String, not Number")
+}
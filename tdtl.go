@@ -20,7 +20,10 @@ import (
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
-var _ TDTL = (*tdtl)(nil)
+var (
+	_ TDTL    = (*tdtl)(nil)
+	_ Checker = (*tdtl)(nil)
+)
 
 type tdtl struct {
 	target   string
@@ -28,6 +31,7 @@ type tdtl struct {
 	listener *TDTLListener
 	extFunc  map[string]ContextFunc
 	fields   map[string]string
+	options  *options
 }
 
 type TDTL interface {
@@ -37,20 +41,43 @@ type TDTL interface {
 	Exec(map[string]Node) (map[string]Node, error)
 }
 
-func NewTDTL(sql string, extFunc map[string]ContextFunc) (TDTL, error) {
+// Checker is implemented by the rules of NewTDTL, e.g. tql.(Checker).Check(schemas).
+type Checker interface {
+	// Check infers the output type of each field from the schemas of the entities,
+	// a field whose type cannot be inferred is Undefined. Mismatches are returned as TypeErrors.
+	Check(schemas map[string]Schema) (map[string]Type, error)
+}
+
+func NewTDTL(sql string, extFunc map[string]ContextFunc, opts ...Option) (TDTL, error) {
 	parse, listener := parse(sql)
 	antlr.ParseTreeWalkerDefault.Walk(listener, parse.Root())
 	err := listener.error()
 	if err != nil {
 		return nil, err
 	}
-	return &tdtl{
+	Q := &tdtl{
 		listener: listener,
 		target:   listener.target,
 		sources:  listener.sources,
 		fields:   listener.fields,
 		extFunc:  extFunc,
-	}, nil
+		options:  newOptions(opts),
+	}
+	if err := Q.options.importPackages(listener.imports); err != nil {
+		return nil, err
+	}
+	if err := Q.options.createFunctions(listener.creates, extFunc); err != nil {
+		return nil, err
+	}
+	if err := checkCalls(Q.expr(), extFunc, Q.options); err != nil {
+		return nil, err
+	}
+	if len(Q.options.schemas) > 0 {
+		if _, err := Q.Check(Q.options.schemas); err != nil {
+			return nil, err
+		}
+	}
+	return Q, nil
 }
 
 func (Q *tdtl) Target() string {
@@ -65,17 +92,36 @@ func (Q *tdtl) Fields() map[string]string {
 	return Q.fields
 }
 
+// Check infers the output type of each field from the schemas of the entities,
+// a field whose type cannot be inferred is Undefined. Mismatches are returned as TypeErrors.
+func (Q *tdtl) Check(schemas map[string]Schema) (map[string]Type, error) {
+	return checkTypes(Q.expr(), schemas, Q.extFunc, Q.options)
+}
+
 func (Q *tdtl) expr() Expr {
 	return Q.listener.Expr()
 }
 
 func (Q *tdtl) Exec(input map[string]Node) (map[string]Node, error) {
-	ctx := NewMapContext(input, Q.extFunc)
-	result := EvalRuleQL(ctx, Q.expr())
-	retCtx := NewJSONContext(result.String())
+	ctx := MutilContext{DefaultValue, modeContext{NewMapContext(input, Q.extFunc), Q.options}}
+	var fields FieldsExpr
+	if expr, ok := Q.expr().(*SelectStatementExpr); ok {
+		fields = expr.fields
+	}
+	// the nodes keep their types, e.g. a decimal is not read back from json as a float.
+	nodes, errs := evalFields(ctx, fields)
 	ret := map[string]Node{}
-	for k, _ := range Q.listener.fields {
-		ret[k] = retCtx.Value(k)
+	for k := range Q.listener.fields {
+		ret[k] = UNDEFINED_RESULT
+	}
+	for i, field := range fields {
+		// the fields which failed are undefined, the others are still returned.
+		if field.alias != "" && nodes[i].Error() == nil {
+			ret[field.alias] = Q.options.applyPrecision(field.alias, nodes[i])
+		}
+	}
+	if len(errs) > 0 {
+		return ret, errs
 	}
 	return ret, nil
 }
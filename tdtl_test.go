@@ -114,6 +114,41 @@ func TestExec3(t *testing.T) {
 //	t.Log(result)
 //}
 
+func TestExecPrecision(t *testing.T) {
+	tqlString := `insert into entity3 select entity1.temp * 1.0 as temp, entity1.temp / 3 as third, entity1.name as name`
+	input := map[string]Node{
+		"entity1.temp": FloatNode(50.1),
+		"entity1.name": StringNode("light"),
+	}
+
+	tqlInst, err := NewTDTL(tqlString, nil)
+	assert.Nil(t, err)
+	result, err := tqlInst.Exec(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "50.1", result["temp"].String())
+	assert.Equal(t, "16.7", result["third"].String())
+
+	tqlInst, err = NewTDTL(tqlString, nil, WithPrecision(3), WithFieldPrecision("third", 1))
+	assert.Nil(t, err)
+	result, err = tqlInst.Exec(map[string]Node{
+		"entity1.temp": FloatNode(50.1234),
+		"entity1.name": StringNode("light"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "50.123", result["temp"].String())
+	assert.Equal(t, "16.7", result["third"].String())
+	assert.Equal(t, "light", result["name"].String())
+
+	// the precision is kept in the output, with trailing zeros.
+	result, err = tqlInst.Exec(map[string]Node{
+		"entity1.temp": FloatNode(50.1),
+		"entity1.name": StringNode("light"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "50.100", result["temp"].String())
+	assert.Equal(t, "16.7", result["third"].String())
+}
+
 func TestString(t *testing.T) {
 	tqlText := "insert into SS4c1e33a1-6899-4643-a6b3-46cf37950b7f select 54cf69fc-78c3-4f79-9f6b-5d5e5bd8d3c0.sysField._spacePath  + '/4c1e33a1-6899-4643-a6b3-46cf37950b7f' as sysField._spacePath"
 	tqlIns, err := NewTDTL(tqlText, nil)
@@ -121,3 +156,24 @@ func TestString(t *testing.T) {
 	t.Log(tqlIns.Target())
 
 }
+
+func TestExecTypes(t *testing.T) {
+	tqlInst, err := NewTDTL(`insert into target select decimal('12345678901234567890.123456789') as d,
+		timestamp(0) as ts, hex_decode('0aff') as b, entity1.temp as temp`, nil, WithPrecision(2))
+	assert.Nil(t, err)
+	result, err := tqlInst.Exec(map[string]Node{"entity1.temp": FloatNode(50.123)})
+	assert.Nil(t, err)
+	// the fields keep the types of their values.
+	assert.Equal(t, Decimal, result["d"].Type())
+	assert.Equal(t, "12345678901234567890.12", result["d"].String())
+	assert.Equal(t, Timestamp, result["ts"].Type())
+	assert.Equal(t, "1970-01-01T00:00:00Z", result["ts"].String())
+	assert.Equal(t, BytesNode{0x0a, 0xff}, result["b"])
+	assert.Equal(t, "50.12", result["temp"].String())
+
+	tqlInst, err = NewTDTL(`insert into target select decimal('12345678901234567890.123456789') as d`, nil)
+	assert.Nil(t, err)
+	result, err = tqlInst.Exec(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "12345678901234567890.123456789", result["d"].String())
+}
@@ -34,24 +34,40 @@ func (*JSONPathExpr) expr()        {}
 func (*SwitchExpr) expr()          {}
 func (CaseListExpr) expr()         {}
 func (*CaseExpr) expr()            {}
+func (*InExpr) expr()              {}
 
-func (BoolNode) expr()   {}
-func (IntNode) expr()    {}
-func (FloatNode) expr()  {}
-func (StringNode) expr() {}
-func (*CallExpr) expr()  {}
-func (JSONNode) expr()   {}
+func (BoolNode) expr()      {}
+func (IntNode) expr()       {}
+func (FloatNode) expr()     {}
+func (StringNode) expr()    {}
+func (DecimalNode) expr()   {}
+func (TimestampNode) expr() {}
+func (DurationNode) expr()  {}
+func (*CallExpr) expr()     {}
+func (JSONNode) expr()      {}
+
+// Pos is the line and column of an expression in the rule.
+type Pos struct {
+	Line   int
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
 
 //BinaryExpr
 type BinaryExpr struct {
 	Op  int
 	LHS Expr
 	RHS Expr
+	pos Pos
 }
 
 //JSONPathExpr xpath
 type JSONPathExpr struct {
 	val string
+	pos Pos
 }
 
 //CallExpr
@@ -59,6 +75,7 @@ type CallExpr struct {
 	raw  string
 	key  string
 	args []Expr
+	pos  Pos
 }
 
 func (e *CallExpr) String() string {
@@ -78,6 +95,15 @@ type SwitchExpr struct {
 	exp  Expr
 	list []*CaseExpr
 	last Expr
+	pos  Pos
+}
+
+//InExpr x [NOT] IN (values), a single array is searched by its elements
+type InExpr struct {
+	exp  Expr
+	list []Expr
+	not  bool
+	pos  Pos
 }
 
 //CaseListExpr
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+// BytesEncoding is the text encoding of a BytesNode in json.
+type BytesEncoding int
+
+const (
+	// Base64Encoding encodes bytes as "base64:<std base64>".
+	Base64Encoding BytesEncoding = iota
+	// HexEncoding encodes bytes as "hex:<lower case hex>".
+	HexEncoding
+)
+
+const (
+	base64Hint = "base64:"
+	hexHint    = "hex:"
+)
+
+// DefaultBytesEncoding is the encoding used by BytesNode.Raw() and BytesNode.String().
+var DefaultBytesEncoding = Base64Encoding
+
+// BytesNode is a binary payload, it is stored in json as an encoded string
+// prefixed by a type hint, e.g. "base64:AAEC", so it can be decoded back.
+type BytesNode []byte
+
+// ParseBytes decode the hinted text of a BytesNode, ok is false if s has no hint.
+func ParseBytes(s string) (BytesNode, bool) {
+	switch {
+	case strings.HasPrefix(s, base64Hint):
+		b, err := base64.StdEncoding.DecodeString(s[len(base64Hint):])
+		return BytesNode(b), err == nil
+	case strings.HasPrefix(s, hexHint):
+		b, err := hex.DecodeString(s[len(hexHint):])
+		return BytesNode(b), err == nil
+	}
+	return nil, false
+}
+
+// stringBytes returns the bytes of s, decoded by its hint, "base64:" or "hex:",
+// or without a hint the bytes of the text itself. The encoding of a string without
+// hint is not guessed from its content, hex_decode and base64_decode decode it.
+// ok is false if s has a hint but is malformed.
+func stringBytes(s string) (b BytesNode, ok bool) {
+	if b, ok := ParseBytes(s); ok {
+		return b, true
+	}
+	if strings.HasPrefix(s, base64Hint) || strings.HasPrefix(s, hexHint) {
+		return nil, false
+	}
+	return BytesNode(s), true
+}
+
+// Encode returns the hinted text of r in the given encoding.
+func (r BytesNode) Encode(encoding BytesEncoding) string {
+	if encoding == HexEncoding {
+		return hexHint + hex.EncodeToString(r)
+	}
+	return base64Hint + base64.StdEncoding.EncodeToString(r)
+}
+
+// Index returns the byte at i as an IntNode, a negative i counts from the end.
+func (r BytesNode) Index(i int) Node {
+	if i < 0 {
+		i += len(r)
+	}
+	if i < 0 || i >= len(r) {
+		return UNDEFINED_RESULT
+	}
+	return IntNode(r[i])
+}
+
+func (r BytesNode) Type() Type   { return Bytes }
+func (r BytesNode) Error() error { return nil }
+func (r BytesNode) To(typ Type) Node {
+	switch typ {
+	case Bytes:
+		return r
+	case String:
+		return StringNode(r.String())
+	case Array:
+		ret := New("[]")
+		for _, b := range r {
+			ret.Append("", IntNode(b))
+		}
+		return ret.Node()
+	}
+	return UNDEFINED_RESULT
+}
+func (r BytesNode) Raw() []byte {
+	return []byte(strconv.Quote(r.String()))
+}
+func (r BytesNode) String() string {
+	return r.Encode(DefaultBytesEncoding)
+}
+
+// NewBytes returns a Collect of the hinted text of raw.
+func NewBytes(raw []byte) *Collect {
+	return &Collect{
+		value:    []byte(BytesNode(raw).String()),
+		datatype: String,
+	}
+}
+
+// evalBinaryBytes compare bytes by content or concatenate them with '+',
+// a string on the right side is decoded by its hint.
+func evalBinaryBytes(op int, lhs BytesNode, rhs Node) Node {
+	r, ok := rhs.To(Bytes).(BytesNode)
+	if !ok {
+		return UNDEFINED_RESULT
+	}
+	switch op {
+	case parser.TDTLParserADD:
+		ret := make(BytesNode, 0, len(lhs)+len(r))
+		return append(append(ret, lhs...), r...)
+	case parser.TDTLLexerEQ:
+		return BoolNode(bytes.Equal(lhs, r))
+	case parser.TDTLLexerNE:
+		return BoolNode(!bytes.Equal(lhs, r))
+	case parser.TDTLLexerLT:
+		return BoolNode(bytes.Compare(lhs, r) < 0)
+	case parser.TDTLLexerLTE:
+		return BoolNode(bytes.Compare(lhs, r) <= 0)
+	case parser.TDTLLexerGT:
+		return BoolNode(bytes.Compare(lhs, r) > 0)
+	case parser.TDTLLexerGTE:
+		return BoolNode(bytes.Compare(lhs, r) >= 0)
+	}
+	return UNDEFINED_RESULT
+}
+
+var bytesFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	return args[0].To(Bytes)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBytesNode(t *testing.T) {
+	b := BytesNode{0x00, 0x01, 0xfe}
+	assert.Equal(t, Bytes, b.Type())
+	assert.Equal(t, `"base64:AAH+"`, string(b.Raw()))
+	assert.Equal(t, "hex:0001fe", b.Encode(HexEncoding))
+	assert.Equal(t, StringNode("base64:AAH+"), b.To(String))
+	assert.Equal(t, "[0,1,254]", b.To(Array).String())
+
+	assert.Equal(t, IntNode(1), b.Index(1))
+	assert.Equal(t, IntNode(254), b.Index(-1))
+	assert.Equal(t, UNDEFINED_RESULT, b.Index(3))
+
+	assert.Equal(t, b, StringNode("base64:AAH+").To(Bytes))
+	assert.Equal(t, b, StringNode("hex:0001fe").To(Bytes))
+	assert.Equal(t, BytesNode("abc"), StringNode("abc").To(Bytes))
+
+	_, ok := ParseBytes("hex:zz")
+	assert.False(t, ok)
+}
+
+func TestBytesCollect(t *testing.T) {
+	b := BytesNode{0x00, 0x01, 0xfe}
+	cc := New(`{"id":1}`)
+	cc.SetBytes("payload", b)
+	assert.Equal(t, `{"id":1,"payload":"base64:AAH+"}`, cc.String())
+	assert.Equal(t, b, cc.Get("payload").To(Bytes))
+	assert.Equal(t, b, NewBytes(b).To(Bytes))
+	assert.Equal(t, UNDEFINED_RESULT, cc.Get("id").To(Bytes))
+}
+
+func TestStringNodeRaw(t *testing.T) {
+	tests := []struct {
+		str  StringNode
+		want string
+	}{
+		{"abc", `"abc"`},
+		{`a"b\c`, `"a\"b\\c"`},
+		{"a\nb\tc\x01", `"a\nb\tc\u0001"`},
+		{"中文", `"中文"`},
+		{"\xff", "\"�\""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, string(tt.str.Raw()), tt.want)
+	}
+}
+
+func TestBytesEval(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"payload": BytesNode{0x00, 0x01, 0xfe},
+		"frame":   New(`[7,8,9]`),
+	}, nil)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`payload[2]`, "254"},
+		{`payload[0] + 1`, "1"},
+		{`payload[5]`, ""},
+		{`frame[1]`, "8"},
+		{`payload = 'hex:0001fe'`, "true"},
+		{`payload <> bytes('hex:00')`, "true"},
+		{`payload + 'hex:ff'`, "base64:AAH+/w=="},
+		{`typeof(payload)`, "Bytes"},
+		{`base64(payload)`, "AAH+"},
+		{` CASE payload WHEN bytes('hex:0001fe') THEN 'hit' ELSE 'miss'`, "hit"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr).String(), tt.expr)
+	}
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode decides how a DecimalNode drops digits.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds half away from zero, 2.5 => 3, -2.5 => -3.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds half to the even neighbour, 2.5 => 2, 3.5 => 4.
+	RoundHalfEven
+	// RoundHalfDown rounds half toward zero, 2.5 => 2, -2.5 => -2.
+	RoundHalfDown
+	// RoundDown truncates toward zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+)
+
+var roundingModes = map[string]RoundingMode{
+	"half_up":   RoundHalfUp,
+	"half_even": RoundHalfEven,
+	"half_down": RoundHalfDown,
+	"down":      RoundDown,
+	"up":        RoundUp,
+	"floor":     RoundFloor,
+	"ceiling":   RoundCeiling,
+}
+
+// ParseRoundingMode returns the rounding mode named by s, e.g. "half_even".
+func ParseRoundingMode(s string) (RoundingMode, bool) {
+	mode, ok := roundingModes[strings.ToLower(s)]
+	return mode, ok
+}
+
+const (
+	// MaxDecimalScale bounds the exponent and the scale of a decimal, a larger
+	// one is invalid and an operation which exceeds it is out of range.
+	MaxDecimalScale = 1000
+	// defaultDivisionScale is the number of fractional digits kept by a
+	// decimal division that does not terminate.
+	defaultDivisionScale = 16
+)
+
+var errInvalidDecimal = errors.New("invalid decimal")
+
+var bigTen = big.NewInt(10)
+
+// DecimalNode is an arbitrary-precision decimal number,
+// its value is unscaled * 10^-scale.
+type DecimalNode struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewDecimal parse decimal from string, e.g. "12.345", "-1e-3".
+func NewDecimal(s string) (DecimalNode, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return DecimalNode{}, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+	}
+
+	var exp int64
+	if idx := strings.IndexAny(str, "eE"); idx != -1 {
+		e, err := strconv.ParseInt(str[idx+1:], 10, 32)
+		if err != nil {
+			return DecimalNode{}, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+		}
+		if e < -MaxDecimalScale || e > MaxDecimalScale {
+			return DecimalNode{}, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+		}
+		exp, str = e, str[:idx]
+	}
+
+	sign := ""
+	if str != "" && (str[0] == '+' || str[0] == '-') {
+		sign, str = str[:1], str[1:]
+	}
+
+	intPart, fracPart := str, ""
+	if idx := strings.IndexByte(str, '.'); idx != -1 {
+		intPart, fracPart = str[:idx], str[idx+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) != -1 {
+		return DecimalNode{}, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > MaxDecimalScale {
+		return DecimalNode{}, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+	}
+	unscaled, _ := new(big.Int).SetString(sign+digits, 10)
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	return DecimalNode{unscaled: unscaled, scale: int32(scale)}, nil
+}
+
+// NewDecimalFromInt returns the decimal of an integer.
+func NewDecimalFromInt(v int64) DecimalNode {
+	return DecimalNode{unscaled: big.NewInt(v)}
+}
+
+// NewDecimalFromFloat returns the decimal of the shortest representation of v.
+func NewDecimalFromFloat(v float64) (DecimalNode, error) {
+	return NewDecimal(strconv.FormatFloat(v, 'g', -1, 64))
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+func (r DecimalNode) value() *big.Int {
+	if r.unscaled == nil {
+		return new(big.Int)
+	}
+	return r.unscaled
+}
+
+// rescale returns r with the given scale, scale must not be less than r.scale.
+func (r DecimalNode) rescale(scale int32) *big.Int {
+	if scale == r.scale {
+		return r.value()
+	}
+	return new(big.Int).Mul(r.value(), pow10(int64(scale-r.scale)))
+}
+
+func maxScale(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Add returns r + y.
+func (r DecimalNode) Add(y DecimalNode) DecimalNode {
+	scale := maxScale(r.scale, y.scale)
+	return DecimalNode{unscaled: new(big.Int).Add(r.rescale(scale), y.rescale(scale)), scale: scale}
+}
+
+// Sub returns r - y.
+func (r DecimalNode) Sub(y DecimalNode) DecimalNode {
+	scale := maxScale(r.scale, y.scale)
+	return DecimalNode{unscaled: new(big.Int).Sub(r.rescale(scale), y.rescale(scale)), scale: scale}
+}
+
+// Mul returns r * y, a product whose scale exceeds MaxDecimalScale is out of range.
+func (r DecimalNode) Mul(y DecimalNode) (DecimalNode, error) {
+	scale := int64(r.scale) + int64(y.scale)
+	if scale > MaxDecimalScale {
+		return DecimalNode{}, fmt.Errorf("%w: decimal scale %d", ErrOutOfRange, scale)
+	}
+	return DecimalNode{unscaled: new(big.Int).Mul(r.value(), y.value()), scale: int32(scale)}, nil
+}
+
+// Div returns r / y rounded to scale fractional digits, trailing zeros are removed.
+func (r DecimalNode) Div(y DecimalNode, scale int32, mode RoundingMode) (DecimalNode, error) {
+	if y.Sign() == 0 {
+		return DecimalNode{}, ErrDivisionByZero
+	}
+	if scale < 0 || scale > MaxDecimalScale {
+		return DecimalNode{}, fmt.Errorf("%w: decimal scale %d", ErrOutOfRange, scale)
+	}
+	// r / y = (ru * 10^(scale+1+ys-rs)) / yu * 10^-(scale+1), one guard digit for rounding.
+	shift := int64(scale) + 1 + int64(y.scale) - int64(r.scale)
+	num, den := new(big.Int).Set(r.value()), new(big.Int).Set(y.value())
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		// keep the sticky bit so that 0.5000..1 is not treated as a tie.
+		q.Mul(q, bigTen)
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+		return DecimalNode{unscaled: q, scale: scale + 2}.Round(scale, mode).normalize(), nil
+	}
+	return DecimalNode{unscaled: q, scale: scale + 1}.Round(scale, mode).normalize(), nil
+}
+
+// Round returns r rounded to scale fractional digits.
+func (r DecimalNode) Round(scale int32, mode RoundingMode) DecimalNode {
+	if scale >= r.scale {
+		return DecimalNode{unscaled: r.rescale(scale), scale: scale}
+	}
+	divisor := pow10(int64(r.scale - scale))
+	q, rem := new(big.Int).QuoRem(r.value(), divisor, new(big.Int))
+	if rem.Sign() == 0 {
+		return DecimalNode{unscaled: q, scale: scale}
+	}
+
+	negative := r.value().Sign() < 0
+	half := new(big.Int).Abs(rem)
+	half.Mul(half, big.NewInt(2))
+	cmpHalf := half.Cmp(divisor)
+
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = cmpHalf >= 0
+	case RoundHalfDown:
+		away = cmpHalf > 0
+	case RoundHalfEven:
+		away = cmpHalf > 0 || (cmpHalf == 0 && q.Bit(0) == 1)
+	case RoundDown:
+		away = false
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = negative
+	case RoundCeiling:
+		away = !negative
+	}
+	if away {
+		if negative {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return DecimalNode{unscaled: q, scale: scale}
+}
+
+// normalize removes trailing fractional zeros.
+func (r DecimalNode) normalize() DecimalNode {
+	u, scale := new(big.Int).Set(r.value()), r.scale
+	rem := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(u, bigTen, rem)
+		if m.Sign() != 0 {
+			break
+		}
+		u, scale = q, scale-1
+	}
+	return DecimalNode{unscaled: u, scale: scale}
+}
+
+// Cmp compares r and y, returns -1 if r < y, 0 if r == y, +1 if r > y.
+func (r DecimalNode) Cmp(y DecimalNode) int {
+	scale := maxScale(r.scale, y.scale)
+	return r.rescale(scale).Cmp(y.rescale(scale))
+}
+
+// Sign returns -1, 0 or +1 by the sign of r.
+func (r DecimalNode) Sign() int {
+	return r.value().Sign()
+}
+
+// Neg returns -r.
+func (r DecimalNode) Neg() DecimalNode {
+	return DecimalNode{unscaled: new(big.Int).Neg(r.value()), scale: r.scale}
+}
+
+// Scale returns the number of fractional digits.
+func (r DecimalNode) Scale() int32 {
+	return r.scale
+}
+
+// Float64 returns the nearest float64 of r.
+func (r DecimalNode) Float64() float64 {
+	f, _ := strconv.ParseFloat(r.String(), 64)
+	return f
+}
+
+// Int64 returns the integer part of r, ok is false if it overflows int64.
+func (r DecimalNode) Int64() (int64, bool) {
+	i := r.Round(0, RoundDown).value()
+	if !i.IsInt64() {
+		return 0, false
+	}
+	return i.Int64(), true
+}
+
+func (r DecimalNode) Type() Type   { return Decimal }
+func (r DecimalNode) Error() error { return nil }
+func (r DecimalNode) To(typ Type) Node {
+	switch typ {
+	case Number, Decimal:
+		return r
+	case Int:
+		if i, ok := r.Int64(); ok {
+			return IntNode(i)
+		}
+		return errorNode(ErrIntegerOverflow)
+	case Float:
+		return FloatNode(r.Float64())
+	case String:
+		return StringNode(r.String())
+	}
+	return UNDEFINED_RESULT
+}
+func (r DecimalNode) Raw() []byte {
+	return []byte(r.String())
+}
+func (r DecimalNode) String() string {
+	digits := new(big.Int).Abs(r.value()).String()
+	sign := ""
+	if r.value().Sign() < 0 {
+		sign = "-"
+	}
+	if r.scale <= 0 {
+		return sign + digits + strings.Repeat("0", int(-r.scale))
+	}
+	scale := int(r.scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// toDecimal convert number or numeric string node to decimal.
+func toDecimal(node Node) (DecimalNode, bool) {
+	switch node := node.(type) {
+	case DecimalNode:
+		return node, true
+	case IntNode:
+		return NewDecimalFromInt(int64(node)), true
+	case FloatNode:
+		d, err := NewDecimalFromFloat(float64(node))
+		return d, err == nil
+	case StringNode:
+		d, err := NewDecimal(string(node))
+		return d, err == nil
+	}
+	return DecimalNode{}, false
+}
+
+var decimalFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	return args[0].To(Decimal)
+}
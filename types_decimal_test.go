@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDecimal(s string) DecimalNode {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"0", "0", false},
+		{"12.345", "12.345", false},
+		{"-0.001", "-0.001", false},
+		{".5", "0.5", false},
+		{"+1.50", "1.50", false},
+		{"1e3", "1000", false},
+		{"1.5e-3", "0.0015", false},
+		{"123456789012345678901234567890.1", "123456789012345678901234567890.1", false},
+		{"", "", true},
+		{"abc", "", true},
+		{"1.2.3", "", true},
+		{"1e", "", true},
+		{"1e1000", "1" + strings.Repeat("0", 1000), false},
+		{"1e2000000000", "", true},
+		{"0.5e-2147483648", "", true},
+		{"0.5e-1000", "", true},
+		{"1e99999999999", "", true},
+	}
+	for _, tt := range tests {
+		got, err := NewDecimal(tt.raw)
+		if tt.wantErr {
+			assert.Error(t, err, tt.raw)
+			continue
+		}
+		assert.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.want, got.String(), tt.raw)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := mustDecimal("0.1"), mustDecimal("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	product, err := a.Mul(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.02", product.String())
+	assert.Equal(t, 0, a.Add(b).Cmp(mustDecimal("0.30")))
+
+	small := mustDecimal("1e-1000")
+	_, err = small.Mul(small)
+	assert.True(t, errors.Is(err, ErrOutOfRange))
+
+	ret, err := mustDecimal("1").Div(mustDecimal("3"), 4, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3333", ret.String())
+
+	ret, err = mustDecimal("2").Div(mustDecimal("3"), 4, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.6667", ret.String())
+
+	ret, err = mustDecimal("1").Div(mustDecimal("4"), 16, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.25", ret.String())
+
+	_, err = a.Div(mustDecimal("0"), 4, RoundHalfEven)
+	assert.Error(t, err)
+
+	_, err = a.Div(b, MaxDecimalScale+1, RoundHalfEven)
+	assert.True(t, errors.Is(err, ErrOutOfRange))
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		raw  string
+		mode RoundingMode
+		want string
+	}{
+		{"2.5", RoundHalfUp, "3"},
+		{"-2.5", RoundHalfUp, "-3"},
+		{"2.5", RoundHalfEven, "2"},
+		{"3.5", RoundHalfEven, "4"},
+		{"2.5", RoundHalfDown, "2"},
+		{"2.51", RoundHalfDown, "3"},
+		{"2.9", RoundDown, "2"},
+		{"-2.9", RoundDown, "-2"},
+		{"2.1", RoundUp, "3"},
+		{"-2.1", RoundFloor, "-3"},
+		{"2.1", RoundFloor, "2"},
+		{"-2.9", RoundCeiling, "-2"},
+		{"2.1", RoundCeiling, "3"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, mustDecimal(tt.raw).Round(0, tt.mode).String(), tt.raw)
+	}
+	assert.Equal(t, "1.2350", mustDecimal("1.235").Round(4, RoundHalfEven).String())
+}
+
+func TestDecimalTo(t *testing.T) {
+	d := mustDecimal("12.75")
+	assert.Equal(t, IntNode(12), d.To(Int))
+	assert.Equal(t, FloatNode(12.75), d.To(Float))
+	assert.Equal(t, StringNode("12.75"), d.To(String))
+	assert.Equal(t, d, StringNode("12.75").To(Decimal))
+	assert.Equal(t, "3", IntNode(3).To(Decimal).String())
+	assert.Equal(t, "0.1", FloatNode(0.1).To(Decimal).String())
+	assert.Equal(t, Undefined, mustDecimal("1e30").To(Int).Type())
+}
+
+func TestDecimalEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`DECIMAL '0.1' + DECIMAL '0.2'`, "0.3"},
+		{`decimal('0.1') + 0.2`, "0.3"},
+		{`decimal('1.10') * 3`, "3.30"},
+		{`3 - decimal('0.5')`, "2.5"},
+		{`decimal('10') / 4`, "2.5"},
+		{`decimal('1') / 3`, "0.3333333333333333"},
+		{`decimal('5.5') % 2`, "1.5"},
+		{`'1.25' + decimal('1')`, "1.251"},
+		{`'1.25' - decimal('1')`, "0.25"},
+		{`decimal('0.3') = 0.1 + 0.2`, "false"},
+		{`decimal('0.3') = decimal('0.1') + 0.2`, "true"},
+		{`decimal('2') > 1.5`, "true"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(DefaultValue, expr).String(), tt.expr)
+	}
+}
+
+func TestDecimalDivisionOption(t *testing.T) {
+	tests := []struct {
+		expr string
+		opts []Option
+		want string
+	}{
+		{`decimal('2') / 3`, nil, "0.6666666666666667"},
+		{`decimal('2') / 3`, []Option{WithDecimalDivision(4, RoundDown)}, "0.6666"},
+		{`decimal('2') / 3`, []Option{WithDecimalDivision(2, RoundHalfUp)}, "0.67"},
+		{`decimal('2') / 3`, []Option{WithDecimalDivision(-1, RoundHalfUp)}, "1"},
+		{`decimal('1.5') / 3`, []Option{WithDecimalDivision(0, RoundHalfUp)}, "0.5"},
+		{`decimal('1e-600') * decimal('1e-600')`, nil, `[1:0]decimal("1e-600") * decimal("1e-600"): out of range: decimal scale 1200`},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		got := eval(ContextWithOptions(DefaultValue, tt.opts...), expr)
+		if err := got.Error(); err != nil {
+			assert.Equal(t, tt.want, err.Error(), tt.expr)
+			continue
+		}
+		assert.Equal(t, tt.want, got.String(), tt.expr)
+	}
+
+	// the options of one rule do not change the division of another.
+	expr, err := ParseExpr(`decimal('2') / 3`)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.67", eval(ContextWithOptions(DefaultValue, WithDecimalDivision(2, RoundHalfUp)), expr).String())
+	assert.Equal(t, "0.6666666666666667", eval(DefaultValue, expr).String())
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package tdtl
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tdtl/pkg/json/gjson"
 )
 
 func TestType(t *testing.T) {
@@ -33,7 +35,16 @@ func TestType(t *testing.T) {
 		a := FloatNode(1.1)
 		b := FloatNode(0.1)
 		c := a / b
-		assert.Equal(t, c.String(), "11.000000", "The two words should be the same.")
+		assert.Equal(t, c.String(), "11", "The two words should be the same.")
+	}
+
+	{
+		assert.Equal(t, "50.1", FloatNode(50.1).String())
+		assert.Equal(t, "11", FloatNode(11).String())
+		assert.Equal(t, "-0.25", string(FloatNode(-0.25).Raw()))
+		assert.Equal(t, "1e-7", FloatNode(0.0000001).String())
+		assert.Equal(t, "1e+21", FloatNode(1e21).String())
+		assert.Equal(t, StringNode("50.1"), FloatNode(50.1).To(String))
 	}
 
 	{
@@ -44,6 +55,46 @@ func TestType(t *testing.T) {
 	}
 }
 
+func TestTypeString(t *testing.T) {
+	tests := []struct {
+		typ  Type
+		want string
+	}{
+		{Undefined, "Undefined"},
+		{Null, "Null"},
+		{Bool, "Bool"},
+		{Number, "Number"},
+		{Int, "Int"},
+		{Float, "Float"},
+		{String, "String"},
+		{JSON, "JSON"},
+		{Object, "Object"},
+		{Array, "Array"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.typ.String())
+	}
+}
+
+func TestJSONNodeContainer(t *testing.T) {
+	object := New(`{"a": 1}`)
+	array := New(`[1, 2]`)
+	assert.Equal(t, Object, object.Type())
+	assert.Equal(t, Array, array.Type())
+	assert.Equal(t, Object, object.Get("").Node().Type())
+
+	assert.Equal(t, Object, object.To(JSON).Type())
+	assert.Equal(t, Object, object.To(Object).Type())
+	assert.Equal(t, Undefined, object.To(Array).Type())
+	assert.Equal(t, Array, array.To(Array).Type())
+	assert.Equal(t, Undefined, array.To(Object).Type())
+	assert.Equal(t, Undefined, New(`"abc"`).To(Object).Type())
+
+	assert.Equal(t, Undefined, object.Get("not_exist").Type())
+	assert.Equal(t, Null, New(`{"a": null}`).Get("a").Type())
+	assert.Equal(t, Array, _gjson2JsonNode(gjson.Parse(`[1]`)).Type())
+}
+
 func BenchmarkStringNodeToNumber(b *testing.B) {
 	s := StringNode("123.4")
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,371 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+var (
+	errInvalidTimestamp = errors.New("invalid timestamp")
+	errInvalidDuration  = errors.New("invalid duration")
+)
+
+// timestampLayouts are the ISO-8601 layouts accepted by ParseTimestamp,
+// a layout without zone is read as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// TimestampNode is a point in time.
+type TimestampNode time.Time
+
+// DurationNode is an elapsed time.
+type DurationNode time.Duration
+
+// ParseTimestamp parse ISO-8601 text or an epoch number in s, ms, us or ns.
+func ParseTimestamp(s string) (TimestampNode, error) {
+	str := strings.TrimSpace(s)
+	if epoch, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return EpochTimestamp(epoch), nil
+	}
+	if epoch, err := strconv.ParseFloat(str, 64); err == nil {
+		if ts, ok := epochFloatTimestamp(epoch); ok {
+			return ts, nil
+		}
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, str, time.UTC); err == nil {
+			return TimestampNode(t), nil
+		}
+	}
+	return TimestampNode{}, fmt.Errorf("%w: %q", errInvalidTimestamp, s)
+}
+
+// EpochTimestamp returns the timestamp of an epoch, the unit (s, ms, us or ns)
+// is inferred from the magnitude, e.g. 1524448722000 is read as milliseconds.
+// An epoch below 1e11 is read as seconds, so milliseconds before March 1973, such
+// as To(Int) returns, are misread. from_unixtime and to_unixtime take the unit
+// explicitly and round trip such an epoch.
+func EpochTimestamp(epoch int64) TimestampNode {
+	// epoch is split into seconds, epoch * unit overflows for milliseconds after 2262.
+	perSecond := int64(time.Second / epochUnit(epoch))
+	return TimestampNode(time.Unix(epoch/perSecond, epoch%perSecond*int64(time.Second)/perSecond).UTC())
+}
+
+// epochFloatTimestamp returns the timestamp of a fractional epoch, ok is false
+// if the epoch is out of the range of int64 in its unit.
+func epochFloatTimestamp(epoch float64) (TimestampNode, bool) {
+	whole, frac := math.Modf(epoch)
+	// float64(math.MaxInt64) rounds up to 2^63, so the upper bound is exclusive.
+	if !(whole >= -(1<<63) && whole < 1<<63) {
+		return TimestampNode{}, false
+	}
+	unit := epochUnit(int64(whole))
+	return TimestampNode(EpochTimestamp(int64(whole)).Time().Add(time.Duration(frac * float64(unit)))), true
+}
+
+// epochUnit infers the unit of an epoch from its magnitude,
+// 1e11 seconds is in the year 5138, 1e11 milliseconds in 1973.
+func epochUnit(epoch int64) time.Duration {
+	switch {
+	case -1e11 < epoch && epoch < 1e11:
+		return time.Second
+	case -1e14 < epoch && epoch < 1e14:
+		return time.Millisecond
+	case -1e17 < epoch && epoch < 1e17:
+		return time.Microsecond
+	}
+	return time.Nanosecond
+}
+
+// ParseDuration parse duration such as "5m", "1h30m", "2d" or ISO-8601 "PT5M".
+func ParseDuration(s string) (DurationNode, error) {
+	str := strings.TrimSpace(s)
+	if d, err := time.ParseDuration(str); err == nil {
+		return DurationNode(d), nil
+	}
+	if d, ok := parseDayDuration(str); ok {
+		return DurationNode(d), nil
+	}
+	if d, ok := parseISODuration(str); ok {
+		return DurationNode(d), nil
+	}
+	return 0, fmt.Errorf("%w: %q", errInvalidDuration, s)
+}
+
+// parseDayDuration parse "2d" and "1w" which time.ParseDuration rejects,
+// the rest of the text after the day unit is parsed by time.ParseDuration.
+func parseDayDuration(s string) (time.Duration, bool) {
+	idx := strings.IndexAny(s, "dw")
+	if idx <= 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(s[:idx], 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := 24 * time.Hour
+	if s[idx] == 'w' {
+		unit *= 7
+	}
+	d := time.Duration(n * float64(unit))
+	if rest := s[idx+1:]; rest != "" {
+		r, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, false
+		}
+		if n < 0 {
+			r = -r
+		}
+		d += r
+	}
+	return d, true
+}
+
+// parseISODuration parse ISO-8601 duration without year and month, e.g. P1DT2H30M.
+func parseISODuration(s string) (time.Duration, bool) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign, s = -1, s[1:]
+	}
+	if len(s) < 3 || (s[0] != 'P' && s[0] != 'p') {
+		return 0, false
+	}
+
+	var (
+		d      time.Duration
+		inTime bool
+		num    = ""
+	)
+	for _, c := range strings.ToUpper(s[1:]) {
+		switch {
+		case c == 'T':
+			inTime = true
+		case c >= '0' && c <= '9' || c == '.':
+			num += string(c)
+		default:
+			n, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, false
+			}
+			var unit time.Duration
+			switch {
+			case c == 'W' && !inTime:
+				unit = 7 * 24 * time.Hour
+			case c == 'D' && !inTime:
+				unit = 24 * time.Hour
+			case c == 'H' && inTime:
+				unit = time.Hour
+			case c == 'M' && inTime:
+				unit = time.Minute
+			case c == 'S' && inTime:
+				unit = time.Second
+			default:
+				return 0, false
+			}
+			d += time.Duration(n * float64(unit))
+			num = ""
+		}
+	}
+	if num != "" {
+		return 0, false
+	}
+	return sign * d, true
+}
+
+// Time returns the time.Time of the timestamp.
+func (r TimestampNode) Time() time.Time { return time.Time(r) }
+
+func (r TimestampNode) Type() Type   { return Timestamp }
+func (r TimestampNode) Error() error { return nil }
+
+// To converts r to the epoch in milliseconds as an Int or a Float, which is read back
+// by magnitude, see EpochTimestamp.
+func (r TimestampNode) To(typ Type) Node {
+	switch typ {
+	case Timestamp:
+		return r
+	case Number, Int:
+		return IntNode(r.Time().UnixMilli())
+	case Float:
+		return FloatNode(float64(r.Time().UnixMilli()) + float64(r.Time().Nanosecond()%int(time.Millisecond))/float64(time.Millisecond))
+	case String:
+		return StringNode(r.String())
+	}
+	return UNDEFINED_RESULT
+}
+func (r TimestampNode) Raw() []byte {
+	return []byte(strconv.Quote(r.String()))
+}
+func (r TimestampNode) String() string {
+	return r.Time().Format(time.RFC3339Nano)
+}
+
+// Duration returns the time.Duration of the duration.
+func (r DurationNode) Duration() time.Duration { return time.Duration(r) }
+
+func (r DurationNode) Type() Type   { return Duration }
+func (r DurationNode) Error() error { return nil }
+func (r DurationNode) To(typ Type) Node {
+	switch typ {
+	case Duration:
+		return r
+	case Number, Int:
+		return IntNode(r.Duration() / time.Millisecond)
+	case Float:
+		return FloatNode(float64(r.Duration()) / float64(time.Millisecond))
+	case String:
+		return StringNode(r.String())
+	}
+	return UNDEFINED_RESULT
+}
+func (r DurationNode) Raw() []byte {
+	return []byte(strconv.Quote(r.String()))
+}
+func (r DurationNode) String() string {
+	return r.Duration().String()
+}
+
+// evalBinaryTime eval timestamp and duration operands, numbers and strings
+// on the other side are converted to the type of the time operand.
+func evalBinaryTime(op int, lhs, rhs Node) Node {
+	switch l := lhs.(type) {
+	case TimestampNode:
+		switch r := rhs.(type) {
+		case TimestampNode:
+			if op == parser.TDTLParserSUB {
+				return DurationNode(l.Time().Sub(r.Time()))
+			}
+			return compareTime(op, l.Time().Sub(r.Time()))
+		case DurationNode:
+			switch op {
+			case parser.TDTLParserADD:
+				return TimestampNode(l.Time().Add(r.Duration()))
+			case parser.TDTLParserSUB:
+				return TimestampNode(l.Time().Add(-r.Duration()))
+			}
+			return UNDEFINED_RESULT
+		case IntNode, FloatNode, StringNode:
+			if isBooleanOP(op) || op == parser.TDTLParserNE || op == parser.TDTLParserSUB {
+				if r, ok := rhs.To(Timestamp).(TimestampNode); ok {
+					return evalBinaryTime(op, l, r)
+				}
+			}
+		}
+	case DurationNode:
+		switch r := rhs.(type) {
+		case DurationNode:
+			switch op {
+			case parser.TDTLParserADD:
+				return l + r
+			case parser.TDTLParserSUB:
+				return l - r
+			case parser.TDTLParserDIV:
+				if r == 0 {
+					return errorNode(ErrDivisionByZero)
+				}
+				return FloatNode(float64(l) / float64(r))
+			}
+			return compareTime(op, time.Duration(l-r))
+		case TimestampNode:
+			if op == parser.TDTLParserADD {
+				return TimestampNode(r.Time().Add(l.Duration()))
+			}
+			return UNDEFINED_RESULT
+		case IntNode, FloatNode:
+			f, _ := r.To(Float).(FloatNode)
+			switch op {
+			case parser.TDTLParserMUL:
+				return DurationNode(float64(l) * float64(f))
+			case parser.TDTLParserDIV:
+				if f == 0 {
+					return errorNode(ErrDivisionByZero)
+				}
+				return DurationNode(float64(l) / float64(f))
+			}
+			if r, ok := rhs.To(Duration).(DurationNode); ok {
+				return evalBinaryTime(op, l, r)
+			}
+		case StringNode:
+			if r, ok := r.To(Duration).(DurationNode); ok {
+				return evalBinaryTime(op, l, r)
+			}
+		}
+	default:
+		switch r := rhs.(type) {
+		case TimestampNode:
+			if l, ok := lhs.To(Timestamp).(TimestampNode); ok && op != parser.TDTLParserADD {
+				return evalBinaryTime(op, l, r)
+			}
+		case DurationNode:
+			if op == parser.TDTLParserMUL {
+				return evalBinaryTime(op, r, lhs)
+			}
+			if l, ok := lhs.To(Duration).(DurationNode); ok {
+				return evalBinaryTime(op, l, r)
+			}
+		}
+	}
+	return UNDEFINED_RESULT
+}
+
+// compareTime eval comparison by the sign of the difference of two times.
+func compareTime(op int, diff time.Duration) Node {
+	switch op {
+	case parser.TDTLLexerEQ:
+		return BoolNode(diff == 0)
+	case parser.TDTLLexerNE:
+		return BoolNode(diff != 0)
+	case parser.TDTLLexerLT:
+		return BoolNode(diff < 0)
+	case parser.TDTLLexerLTE:
+		return BoolNode(diff <= 0)
+	case parser.TDTLLexerGT:
+		return BoolNode(diff > 0)
+	case parser.TDTLLexerGTE:
+		return BoolNode(diff >= 0)
+	}
+	return UNDEFINED_RESULT
+}
+
+var timestampFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	return args[0].To(Timestamp)
+}
+
+var intervalFunc = func(args ...Node) Node {
+	if len(args) != 1 {
+		return UNDEFINED_RESULT
+	}
+	return args[0].To(Duration)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2018, 4, 23, 1, 58, 42, 0, time.UTC)
+	tests := []struct {
+		raw  string
+		want time.Time
+	}{
+		{"1524448722", want},
+		{"1524448722000", want},
+		{"1524448722000000", want},
+		{"1524448722000000000", want},
+		{"1524448722.5", want.Add(500 * time.Millisecond)},
+		{"1524448722500", want.Add(500 * time.Millisecond)},
+		{"1524448722000.5", want.Add(500 * time.Microsecond)},
+		{"-1000", time.Unix(-1000, 0)},
+		{"0", time.Unix(0, 0)},
+		// milliseconds and microseconds past 2262 do not overflow as nanoseconds.
+		{"99999999999999", time.Unix(99999999999, 999000000)},
+		{"99999999999999999", time.Unix(99999999999, 999999000)},
+		{"9223372036854775807", time.Unix(0, 9223372036854775807)},
+		{"2018-04-23T01:58:42Z", want},
+		{"2018-04-23T09:58:42+08:00", want},
+		{"2018-04-23T01:58:42.123Z", want.Add(123 * time.Millisecond)},
+		{"2018-04-23T01:58:42", want},
+		{"2018-04-23 01:58:42", want},
+		{"2018-04-23", time.Date(2018, 4, 23, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimestamp(tt.raw)
+		assert.NoError(t, err, tt.raw)
+		assert.True(t, tt.want.Equal(got.Time()), "%s: %v", tt.raw, got)
+	}
+
+	_, err := ParseTimestamp("yesterday")
+	assert.Error(t, err)
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want time.Duration
+	}{
+		{"5m", 5 * time.Minute},
+		{"1h30m", 90 * time.Minute},
+		{"2d", 48 * time.Hour},
+		{"1d12h", 36 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"PT5M", 5 * time.Minute},
+		{"P1DT2H30M", 26*time.Hour + 30*time.Minute},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"-PT1H", -time.Hour},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.raw)
+		assert.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.want, got.Duration(), tt.raw)
+	}
+
+	for _, raw := range []string{"", "5x", "P1M", "PT"} {
+		_, err := ParseDuration(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestTimeNodeTo(t *testing.T) {
+	ts := EpochTimestamp(1524448722000)
+	assert.Equal(t, IntNode(1524448722000), ts.To(Int))
+	assert.Equal(t, StringNode("2018-04-23T01:58:42Z"), ts.To(String))
+	assert.Equal(t, `"2018-04-23T01:58:42Z"`, string(ts.Raw()))
+	assert.Equal(t, ts, IntNode(1524448722000).To(Timestamp))
+	assert.Equal(t, ts, StringNode("2018-04-23T01:58:42Z").To(Timestamp))
+	assert.Equal(t, Timestamp, New(`1524448722000`).To(Timestamp).Type())
+	assert.True(t, errors.Is(FloatNode(1e30).To(Timestamp).Error(), ErrIntegerOverflow))
+
+	// To(Int) is in milliseconds, an epoch below 1e11 is read back by magnitude as seconds,
+	// from_unixtime and to_unixtime with the unit round trip it.
+	small := TimestampNode(time.UnixMilli(1e10).UTC())
+	assert.Equal(t, "1970-04-26T17:46:40Z", small.String())
+	assert.Equal(t, IntNode(1e10), small.To(Int))
+	assert.Equal(t, "2286-11-20T17:46:40Z", small.To(Int).To(Timestamp).String())
+	assert.Equal(t, small, fromUnixtimeFunc(small.To(Int), StringNode("ms")))
+	assert.Equal(t, IntNode(1e10), toUnixtimeFunc(small, StringNode("ms")))
+	far := TimestampNode(time.UnixMilli(9e13).UTC())
+	assert.Equal(t, IntNode(9e13), far.To(Int))
+	assert.Equal(t, far, far.To(Int).To(Timestamp))
+
+	d := DurationNode(90 * time.Second)
+	assert.Equal(t, IntNode(90000), d.To(Int))
+	assert.Equal(t, `"1m30s"`, string(d.Raw()))
+	assert.Equal(t, d, StringNode("1m30s").To(Duration))
+	assert.Equal(t, d, IntNode(90000).To(Duration))
+}
+
+func TestTimeEval(t *testing.T) {
+	ctx := NewJSONContext(JSONRaw.EventJSON)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`INTERVAL '5m'`, "5m0s"},
+		{`INTERVAL '5m' + INTERVAL '30s'`, "5m30s"},
+		{`INTERVAL '5m' * 2`, "10m0s"},
+		{`2 * INTERVAL '5m'`, "10m0s"},
+		{`INTERVAL '10m' / 4`, "2m30s"},
+		{`INTERVAL '10m' / INTERVAL '5m'`, "2"},
+		{`INTERVAL '5m' > INTERVAL '1m'`, "true"},
+		{`INTERVAL '5m' = '300s'`, "true"},
+		{`TIMESTAMP '2018-04-23T02:00:00Z' - timestamp(params.Power.time)`, "1m18s"},
+		{`timestamp(params.Power.time) + INTERVAL '1h'`, "2018-04-23T02:58:42Z"},
+		{`INTERVAL '1h' + timestamp(params.Power.time)`, "2018-04-23T02:58:42Z"},
+		{`timestamp(params.Power.time) - INTERVAL '1d'`, "2018-04-22T01:58:42Z"},
+		{`timestamp(params.Power.time) = timestamp(params.Color.time)`, "true"},
+		{`timestamp(params.Power.time) < TIMESTAMP '2018-04-23'`, "false"},
+		{`timestamp(params.Power.time) >= 1524448722000`, "true"},
+		{`timestamp(params.Power.time) - 1524448662000`, "1m0s"},
+		{`timestamp(params.Power.time) > '2018-04-23T01:00:00Z'`, "true"},
+		{`interval(5)`, "5ms"},
+		{`interval(1500.5)`, "1.5005s"},
+		{`timestamp(1524448722000)`, "2018-04-23T01:58:42Z"},
+		{`timestamp(1524448722)`, "2018-04-23T01:58:42Z"},
+		{`timestamp(1524448722000000000)`, "2018-04-23T01:58:42Z"},
+		{`timestamp(1524448722.25)`, "2018-04-23T01:58:42.25Z"},
+		{`timestamp(-1500)`, "1969-12-31T23:35:00Z"},
+		{`timestamp('1524448722000')`, "2018-04-23T01:58:42Z"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, EvalRuleQL(ctx, expr).String(), tt.expr)
+	}
+}
+
+func TestTypedLiteralError(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{`INTERVAL 'soon'`, "[1:0]invalid INTERVAL literal 'soon'"},
+		{`1 + TIMESTAMP 'today'`, "[1:4]invalid TIMESTAMP literal 'today'"},
+		{`decimal 'x'`, "[1:0]invalid decimal literal 'x'"},
+	}
+	for _, tt := range tests {
+		_, err := ParseExpr(tt.expr)
+		assert.EqualError(t, err, tt.err, tt.expr)
+	}
+}
+
+func TestTypedLiteralKeywordAsIdentifier(t *testing.T) {
+	ctx := NewJSONContext(`{"timestamp": 5, "decimal": 2, "interval": 3}`)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`timestamp > 1`, "true"},
+		{`decimal + 1`, "3"},
+		{`interval * 2`, "6"},
+		{`timestamp(1000)`, "1970-01-01T00:16:40Z"},
+		{`timestamp (1000)`, "1970-01-01T00:16:40Z"},
+		{`DECIMAL '1.5' + decimal`, "3.5"},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, EvalRuleQL(ctx, expr).String(), tt.expr)
+	}
+
+	tqlInst, err := NewTDTL(`insert into t select timestamp as ts, decimal + 1 as d, interval as interval`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "t", tqlInst.Target())
+	assert.Contains(t, tqlInst.Entities(), "timestamp")
+	result, err := tqlInst.Exec(map[string]Node{
+		"timestamp": IntNode(5),
+		"decimal":   IntNode(2),
+		"interval":  IntNode(3),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "5", result["ts"].String())
+	assert.Equal(t, "3", result["d"].String())
+	assert.Equal(t, "3", result["interval"].String())
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// createSpec is a function created by a rule or a script,
+// CREATE FUNCTION name(param, ...) AS body.
+type createSpec struct {
+	pos    Pos
+	name   string
+	params []string
+	body   Expr
+	// text is the text of the body in the input.
+	text string
+}
+
+// CreateFunctions declares in r the functions of script, a list of clauses
+// CREATE FUNCTION name(param, ...) AS expr, e.g. CREATE FUNCTION c2f(x) AS x * 9 / 5 + 32.
+// The rules which share r with WithFunctions call them like the functions written in Go.
+func (r *FunctionRegistry) CreateFunctions(script string) error {
+	parse, listener := parse(script)
+	antlr.ParseTreeWalkerDefault.Walk(listener, parse.Script())
+	if err := listener.error(); err != nil {
+		return err
+	}
+	if len(listener.imports) > 0 {
+		return fmt.Errorf("[%s]IMPORT in a script of functions", listener.imports[0].pos)
+	}
+	o := &options{functions: r}
+	if err := o.createFunctions(listener.creates, nil); err != nil {
+		return err
+	}
+	for _, fn := range o.locals.Functions() {
+		if err := r.Register(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// userFunction is a function written in TQL.
+type userFunction struct {
+	name   string
+	params []string
+	body   Expr
+}
+
+// createFunctions declares the functions created by a rule in its locals. The calls of
+// their bodies are checked like the calls of the rule, a function may not call itself.
+func (o *options) createFunctions(creates []createSpec, extFunc map[string]ContextFunc) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	if o.locals == nil {
+		o.locals = NewFunctionRegistry()
+	}
+	created := map[string]bool{}
+	udfs := make([]*userFunction, 0, len(creates))
+	for _, spec := range creates {
+		if Builtins.lookup(spec.name) != nil {
+			return fmt.Errorf("[%s]function %s is built in", spec.pos, spec.name)
+		}
+		if created[spec.name] {
+			return fmt.Errorf("[%s]function %s created twice", spec.pos, spec.name)
+		}
+		created[spec.name] = true
+		udf := &userFunction{name: spec.name, params: spec.params, body: spec.body}
+		fn := Function{Name: spec.name, Result: Undefined, Description: spec.text, udf: udf}
+		for _, param := range spec.params {
+			fn.Params = append(fn.Params, Param{Name: param})
+		}
+		if err := o.locals.Register(fn); err != nil {
+			return err
+		}
+		udfs = append(udfs, udf)
+	}
+	for i, udf := range udfs {
+		if calls := o.recursion(udf); calls != nil {
+			return fmt.Errorf("[%s]recursive function %s: %s", creates[i].pos, udf.name, strings.Join(calls, " -> "))
+		}
+		if err := checkCalls(udf.body, extFunc, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recursion returns the calls from udf back to udf, nil if it does not call itself.
+func (o *options) recursion(udf *userFunction) []string {
+	visited := map[*userFunction]bool{}
+	var visit func(u *userFunction, calls []string) []string
+	visit = func(u *userFunction, calls []string) []string {
+		for _, call := range ParseFunc(u.body) {
+			fn := o.lookupFunction(call.key)
+			if fn == nil || fn.udf == nil {
+				continue
+			}
+			if fn.udf == udf {
+				return append(calls, call.key)
+			}
+			if visited[fn.udf] {
+				continue
+			}
+			visited[fn.udf] = true
+			if ret := visit(fn.udf, append(calls, call.key)); ret != nil {
+				return ret
+			}
+		}
+		return nil
+	}
+	return visit(udf, []string{udf.name})
+}
+
+// call evaluates the body of u with args bound to its parameters over the context
+// ctx of the caller, site is the call expression of the caller.
+func (u *userFunction) call(ctx Context, o *options, site *CallExpr, args []Node) Node {
+	if len(args) != len(u.params) {
+		return UNDEFINED_RESULT
+	}
+	// a function replaced after its creation may still call itself.
+	for c := ctx; c != nil; {
+		switch x := c.(type) {
+		case modeContext:
+			c = x.Context
+		case *udfContext:
+			if x.fn == u {
+				return errorNode(fmt.Errorf("%w: %s", ErrRecursion, u.name))
+			}
+			c = x.Context
+		default:
+			c = nil
+		}
+	}
+	values := make(map[string]Node, len(args))
+	for i, param := range u.params {
+		values[param] = args[i]
+	}
+	local := &udfContext{Context: ctx, fn: u, site: site, args: NewMapContext(values, nil)}
+	return eval(modeContext{local, o}, u.body)
+}
+
+// udfContext binds the arguments of a call of a user function over the context
+// of the caller, the other json paths are values of the caller.
+type udfContext struct {
+	Context
+	fn *userFunction
+	// site is the call of the function, the stateful calls of the body are kept per site.
+	site *CallExpr
+	args Context
+}
+
+func (c *udfContext) Value(key string) Node {
+	name := key
+	if i := strings.IndexAny(key, ".["); i > 0 {
+		name = key[:i]
+	}
+	for _, param := range c.fn.params {
+		if param == name {
+			return c.args.Value(key)
+		}
+	}
+	return c.Context.Value(key)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFunction(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want map[string]string
+	}{
+		{"create", "CREATE FUNCTION c2f(x) AS x * 9 / 5 + 32\nINSERT INTO target SELECT c2f(dev.temp) as temp",
+			map[string]string{"temp": "212"}},
+		{"caller", `create function c2f(x) as x * 9 / 5 + 32 create function adjust(x) as x + dev.offset
+			insert into target select adjust(c2f(dev.temp)) as temp`,
+			map[string]string{"temp": "214"}},
+		{"path", `CREATE FUNCTION scale(p, k) AS p.lat * k + p.channels[1] INSERT INTO target SELECT scale(dev.location, 2) as x`,
+			map[string]string{"x": "8"}},
+		{"shadow", `CREATE FUNCTION temp(dev) AS dev * 2 INSERT INTO target SELECT temp(dev.offset) + dev.temp as x`,
+			map[string]string{"x": "104"}},
+		{"literal", `CREATE FUNCTION cost(kwh) AS kwh * DECIMAL '0.15' INSERT INTO target SELECT cost(dev.temp) as cost`,
+			map[string]string{"cost": "15.00"}},
+		{"keyword", `CREATE FUNCTION late(timestamp, interval) AS timestamp + interval INSERT INTO target SELECT late(dev.temp, dev.offset) as x`,
+			map[string]string{"x": "102"}},
+		{"function", `create function function(create) as create + 1 insert into target select function(dev.temp) + function(dev.offset) as x`,
+			map[string]string{"x": "104"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tql, err := NewTDTL(tt.sql, nil)
+			assert.NoError(t, err)
+			// the paths of the bodies are parameters or values of the caller, not entities.
+			for entity := range tql.Entities() {
+				assert.Equal(t, "dev", entity)
+			}
+			ret, err := tql.Exec(map[string]Node{
+				"dev.temp":     IntNode(100),
+				"dev.offset":   IntNode(2),
+				"dev.location": New(`{"lat": 2.5, "channels": [1, 3]}`).Node(),
+			})
+			assert.NoError(t, err)
+			for k, v := range tt.want {
+				assert.Equal(t, v, ret[k].String(), k)
+			}
+		})
+	}
+
+	// CREATE and FUNCTION are keywords only at the start of a clause.
+	ctx := NewJSONContext(`{"create": 1, "function": 2}`)
+	for expr, want := range map[string]string{`create + 1`: "2", `function + 1`: "3"} {
+		e, err := ParseExpr(expr)
+		assert.NoError(t, err, expr)
+		assert.Equal(t, want, EvalRuleQL(ctx, e).String(), expr)
+	}
+}
+
+func TestCreateFunctionErrors(t *testing.T) {
+	tests := []struct {
+		sql string
+		err string
+	}{
+		{`create function f(x) as g(x) create function g(x) as f(x) insert into target select f(1) as y`,
+			"[1:16]recursive function f: f -> g -> f"},
+		{`create function f(x) as f(x - 1) insert into target select f(1) as y`,
+			"[1:16]recursive function f: f -> f"},
+		{`create function f(x, ) as x insert into target select f(1) as y`,
+			"[1:21]missing {CREATE, DECIMAL, FUNCTION, IMPORT, INTERVAL, TIMESTAMP, INDENTIFIER} at ')'"},
+		{`create function f(x) insert into target select f(1) as y`,
+			"[1:21]mismatched input 'insert' expecting AS"},
+		{`create function f(x) as insert into target select f(1) as y`,
+			`[1:24]mismatched input 'insert' expecting {'(', '"', CASE, CREATE, DECIMAL, FUNCTION, IMPORT, INTERVAL, TIMESTAMP, TRUE, FALSE, INDENTIFIER, NUMBER, INTEGER, FLOAT, PATHITEM, STRING}`},
+		{`create function f(x, y, x) as x insert into target select f(1, 2, 3) as y`,
+			"[1:24]duplicate parameter x of function f"},
+		{`create function a[0](x) as x insert into target select 1 as y`,
+			"[1:16]invalid function name a[0]"},
+		{`create function abs(x) as x insert into target select abs(1) as y`,
+			"[1:16]function abs is built in"},
+		{`create function f(x) as x create function f(y) as y insert into target select f(1) as y`,
+			"[1:42]function f created twice"},
+		{`create function f(x) as x + abs(x, 1) insert into target select f(1) as y`,
+			"[1:28]abs(x, 1): abs expects 1 arguments, found 2"},
+		{`create function f(x) as x insert into target select f(1, 2) as y`,
+			"[1:52]f(1, 2): f expects 1 arguments, found 2"},
+	}
+	for _, tt := range tests {
+		_, err := NewTDTL(tt.sql, nil)
+		assert.EqualError(t, err, tt.err, tt.sql)
+	}
+
+	tql, err := NewTDTL(`create function f(x) as 10 / x insert into target select f(dev.x) as y`, nil)
+	assert.NoError(t, err)
+	_, err = tql.Exec(map[string]Node{"dev.x": IntNode(0)})
+	assert.EqualError(t, err, "y: [1:27]10 / x: division by zero")
+}
+
+func TestFunctionRegistryCreateFunctions(t *testing.T) {
+	r := NewFunctionRegistry()
+	assert.NoError(t, r.CreateFunctions(`CREATE FUNCTION c2f(x) AS x * 9 / 5 + 32
+		CREATE FUNCTION f2c(x) AS (x - 32) * 5 / 9`))
+	assert.NoError(t, r.CreateFunctions(`CREATE FUNCTION acme.k2f(k) AS c2f(k - 273)`))
+	assert.EqualError(t, r.CreateFunctions(`CREATE FUNCTION k2c(k) AS k - 273 INSERT`),
+		"[1:34]extraneous input 'INSERT' expecting {<EOF>, CREATE, IMPORT}")
+	assert.EqualError(t, r.CreateFunctions(`IMPORT acme CREATE FUNCTION k2c(k) AS k - 273`),
+		"[1:7]IMPORT in a script of functions")
+
+	fn, ok := r.Lookup("f2c")
+	assert.True(t, ok)
+	assert.Equal(t, "f2c(x any) any", fn.Signature())
+	assert.Equal(t, "(x - 32) * 5 / 9", fn.Description)
+
+	// the functions are shared by the rules of the registry.
+	for _, sql := range []string{
+		`insert into target select acme.k2f(dev.k) as f`,
+		`insert into target select c2f(f2c(acme.k2f(dev.k))) as f`,
+	} {
+		tql, err := NewTDTL(sql, nil, WithFunctions(r))
+		assert.NoError(t, err)
+		ret, err := tql.Exec(map[string]Node{"dev.k": IntNode(373)})
+		assert.NoError(t, err)
+		assert.Equal(t, "212", ret["f"].String())
+	}
+
+	// a function replaced after its creation is still stopped when it calls itself.
+	assert.NoError(t, r.CreateFunctions(`CREATE FUNCTION g(x) AS x CREATE FUNCTION h(x) AS g(x) + 1`))
+	h, _ := r.Lookup("h")
+	h.Name = "g"
+	assert.NoError(t, r.Register(h))
+	tql, err := NewTDTL(`insert into target select g(1) as y`, nil, WithFunctions(r))
+	assert.NoError(t, err)
+	_, err = tql.Exec(nil)
+	assert.True(t, errors.Is(err, ErrRecursion))
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Unit is a unit of measure of a dimension, a value v in the unit is
+// v*Factor + Offset in the base unit of the dimension.
+type Unit struct {
+	Dimension string
+	Factor    float64
+	Offset    float64
+}
+
+// UnitRegistry maps unit symbols such as "kWh" or "degF" to units,
+// it is safe for concurrent use.
+type UnitRegistry struct {
+	mu    sync.RWMutex
+	units map[string]Unit
+}
+
+// NewUnitRegistry returns an empty registry.
+func NewUnitRegistry() *UnitRegistry {
+	return &UnitRegistry{units: map[string]Unit{}}
+}
+
+// Register adds or replaces the unit of symbol.
+func (r *UnitRegistry) Register(symbol string, unit Unit) error {
+	if symbol == "" || unit.Dimension == "" {
+		return fmt.Errorf("tdtl: unit %q needs a symbol and a dimension", symbol)
+	}
+	if unit.Factor == 0 || math.IsNaN(unit.Factor) || math.IsInf(unit.Factor, 0) ||
+		math.IsNaN(unit.Offset) || math.IsInf(unit.Offset, 0) {
+		return fmt.Errorf("tdtl: unit %q has an invalid factor or offset", symbol)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.units[symbol] = unit
+	return nil
+}
+
+// Lookup returns the unit of symbol, symbols are case sensitive, e.g. "mW" and "MW".
+func (r *UnitRegistry) Lookup(symbol string) (Unit, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	unit, ok := r.units[symbol]
+	return unit, ok
+}
+
+// Convert converts value from a unit to another of the same dimension,
+// the arithmetic is done on decimals as ConvertDecimal.
+func (r *UnitRegistry) Convert(value float64, from, to string) (float64, error) {
+	src, dst, err := r.pair(from, to)
+	if err != nil {
+		return 0, err
+	}
+	d, err := NewDecimalFromFloat(value)
+	if err != nil {
+		// NaN and infinities have no decimal.
+		return (value*src.Factor + src.Offset - dst.Offset) / dst.Factor, nil
+	}
+	ret, err := convertDecimal(d, src, dst, defaultDivisionScale, RoundHalfEven)
+	if err != nil {
+		return 0, err
+	}
+	return ret.Float64(), nil
+}
+
+// ConvertDecimal converts value from a unit to another of the same dimension exactly,
+// the factors and offsets are read in their shortest decimal representation
+// and a division which does not terminate keeps 16 fractional digits.
+func (r *UnitRegistry) ConvertDecimal(value DecimalNode, from, to string) (DecimalNode, error) {
+	src, dst, err := r.pair(from, to)
+	if err != nil {
+		return DecimalNode{}, err
+	}
+	return convertDecimal(value, src, dst, defaultDivisionScale, RoundHalfEven)
+}
+
+// pair returns the units from and to, they must be of the same dimension.
+func (r *UnitRegistry) pair(from, to string) (Unit, Unit, error) {
+	src, ok := r.Lookup(from)
+	if !ok {
+		return Unit{}, Unit{}, fmt.Errorf("%w: %q", ErrUnknownUnit, from)
+	}
+	dst, ok := r.Lookup(to)
+	if !ok {
+		return Unit{}, Unit{}, fmt.Errorf("%w: %q", ErrUnknownUnit, to)
+	}
+	if src.Dimension != dst.Dimension {
+		return Unit{}, Unit{}, fmt.Errorf("%w: %s (%s) to %s (%s)", ErrIncompatibleUnits, from, src.Dimension, to, dst.Dimension)
+	}
+	return src, dst, nil
+}
+
+// convertDecimal returns (value * src.Factor + src.Offset - dst.Offset) / dst.Factor,
+// a division which does not terminate keeps scale fractional digits rounded by mode.
+func convertDecimal(value DecimalNode, src, dst Unit, scale int32, mode RoundingMode) (DecimalNode, error) {
+	if src == dst {
+		return value, nil
+	}
+	ret, err := value.Mul(unitDecimal(src.Factor))
+	if err != nil {
+		return DecimalNode{}, err
+	}
+	ret = ret.Add(unitDecimal(src.Offset)).Sub(unitDecimal(dst.Offset))
+	return ret.Div(unitDecimal(dst.Factor), maxScale(scale, ret.scale), mode)
+}
+
+// unitDecimal returns the decimal of a factor or an offset, which Register checks to be finite.
+func unitDecimal(f float64) DecimalNode {
+	d, _ := NewDecimalFromFloat(f)
+	return d
+}
+
+// DefaultUnits is the registry of convert(), applications may register units of their own.
+var DefaultUnits = newDefaultUnits()
+
+func newDefaultUnits() *UnitRegistry {
+	r := NewUnitRegistry()
+	add := func(dimension string, factor, offset float64, symbols ...string) {
+		for _, symbol := range symbols {
+			r.units[symbol] = Unit{Dimension: dimension, Factor: factor, Offset: offset}
+		}
+	}
+
+	// temperatures are based on the rankine and speeds on km/h,
+	// so that their factors and offsets are exact decimals.
+	add("temperature", 1, 0, "degR", "°R", "rankine")
+	add("temperature", 1.8, 0, "K", "kelvin")
+	add("temperature", 1.8, 491.67, "degC", "°C", "C", "celsius")
+	add("temperature", 1, 459.67, "degF", "°F", "F", "fahrenheit")
+
+	add("pressure", 1, 0, "Pa")
+	add("pressure", 1e2, 0, "hPa", "mbar")
+	add("pressure", 1e3, 0, "kPa")
+	add("pressure", 1e6, 0, "MPa")
+	add("pressure", 1e5, 0, "bar")
+	add("pressure", 6894.757293168361, 0, "psi")
+	add("pressure", 101325, 0, "atm")
+	add("pressure", 133.322387415, 0, "mmHg")
+
+	add("energy", 1, 0, "J")
+	add("energy", 1e3, 0, "kJ")
+	add("energy", 1e6, 0, "MJ")
+	add("energy", 3600, 0, "Wh")
+	add("energy", 3.6e6, 0, "kWh")
+	add("energy", 3.6e9, 0, "MWh")
+	add("energy", 4.184, 0, "cal")
+	add("energy", 4184, 0, "kcal")
+
+	add("power", 1, 0, "W")
+	add("power", 1e-3, 0, "mW")
+	add("power", 1e3, 0, "kW")
+	add("power", 1e6, 0, "MW")
+	add("power", 745.6998715822702, 0, "hp")
+
+	add("length", 1, 0, "m")
+	add("length", 1e-3, 0, "mm")
+	add("length", 1e-2, 0, "cm")
+	add("length", 1e3, 0, "km")
+	add("length", 0.0254, 0, "in")
+	add("length", 0.3048, 0, "ft")
+	add("length", 1609.344, 0, "mi")
+
+	add("mass", 1, 0, "kg")
+	add("mass", 1e-3, 0, "g")
+	add("mass", 1e3, 0, "t")
+	add("mass", 0.45359237, 0, "lb")
+	add("mass", 0.028349523125, 0, "oz")
+
+	add("time", 1, 0, "s")
+	add("time", 1e-3, 0, "ms")
+	add("time", 60, 0, "min")
+	add("time", 3600, 0, "h")
+	add("time", 86400, 0, "d")
+
+	add("speed", 3.6, 0, "m/s")
+	add("speed", 1, 0, "km/h")
+	add("speed", 1.609344, 0, "mph")
+	add("speed", 1.852, 0, "kn")
+
+	add("volume", 1, 0, "m3")
+	add("volume", 1e-3, 0, "L")
+	add("volume", 1e-6, 0, "mL")
+	add("volume", 0.003785411784, 0, "gal")
+	return r
+}
+
+// convertFunc convert(value, from, to) converts value between units of the registry
+// of the rule with the decimal arithmetic of the rule, the result is a Float,
+// or a Decimal for a decimal value.
+var convertFunc = func(o *options, args ...Node) Node {
+	if len(args) != 3 {
+		return UNDEFINED_RESULT
+	}
+	if args[0].Type() == Undefined {
+		return args[0]
+	}
+	value, ok1 := numberArg(args[0])
+	from, ok2 := stringArg(args[1])
+	to, ok3 := stringArg(args[2])
+	if !ok1 || !ok2 || !ok3 {
+		return UNDEFINED_RESULT
+	}
+	src, dst, err := o.units.pair(from, to)
+	if err != nil {
+		return errorNode(err)
+	}
+	d, ok := toDecimal(value)
+	if !ok {
+		// NaN and infinities have no decimal.
+		f, _ := floatArg(value)
+		return FloatNode((f*src.Factor + src.Offset - dst.Offset) / dst.Factor)
+	}
+	ret, err := convertDecimal(d, src, dst, o.divisionScale, o.rounding)
+	if err != nil {
+		return errorNode(err)
+	}
+	if value.Type() == Decimal {
+		return ret
+	}
+	return FloatNode(ret.Float64())
+}
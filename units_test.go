@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tdtl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRegistry(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{212, "degF", "degC", 100},
+		{-40, "°C", "°F", -40},
+		{0, "degC", "K", 273.15},
+		{2.5, "bar", "kPa", 250},
+		{14.5, "psi", "bar", 0.9997398075094123},
+		{1.2, "kWh", "Wh", 1200},
+		{3600, "J", "Wh", 1},
+		{100, "km/h", "m/s", 27.77777777777778},
+		{1, "kWh", "kWh", 1},
+		{98.6, "degF", "degC", 37},
+		{37, "degC", "degF", 98.6},
+		{300, "K", "degC", 26.85},
+		{0, "K", "degF", -459.67},
+		{60, "mph", "km/h", 96.56064},
+		{0.1, "kWh", "J", 360000},
+	}
+	for _, tt := range tests {
+		got, err := DefaultUnits.Convert(tt.value, tt.from, tt.to)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "%v %s to %s", tt.value, tt.from, tt.to)
+	}
+
+	_, err := DefaultUnits.Convert(1, "degF", "bar")
+	assert.True(t, errors.Is(err, ErrIncompatibleUnits))
+	assert.EqualError(t, err, "incompatible units: degF (temperature) to bar (pressure)")
+	_, err = DefaultUnits.Convert(1, "parsec", "m")
+	assert.True(t, errors.Is(err, ErrUnknownUnit))
+
+	units := NewUnitRegistry()
+	assert.NoError(t, units.Register("rpm", Unit{Dimension: "frequency", Factor: 1.0 / 60}))
+	assert.NoError(t, units.Register("Hz", Unit{Dimension: "frequency", Factor: 1}))
+	assert.Error(t, units.Register("bad", Unit{Dimension: "frequency"}))
+	assert.Error(t, units.Register("bad", Unit{Factor: 1}))
+	got, err := units.Convert(1500, "rpm", "Hz")
+	assert.NoError(t, err)
+	assert.Equal(t, 25.0, got)
+
+	d, err := DefaultUnits.ConvertDecimal(mustDecimal("98.6"), "degF", "degC")
+	assert.NoError(t, err)
+	assert.Equal(t, "37", d.String())
+	d, err = DefaultUnits.ConvertDecimal(mustDecimal("0.123456789012345678"), "kWh", "Wh")
+	assert.NoError(t, err)
+	assert.Equal(t, "123.456789012345678", d.String())
+	_, err = DefaultUnits.ConvertDecimal(mustDecimal("1"), "degF", "bar")
+	assert.True(t, errors.Is(err, ErrIncompatibleUnits))
+}
+
+func TestConvertFunc(t *testing.T) {
+	ctx := NewMapContext(map[string]Node{
+		"temp":   IntNode(212),
+		"energy": mustDecimal("1.25"),
+	}, nil)
+	tests := []struct {
+		expr string
+		want Node
+	}{
+		{`convert(temp, 'degF', 'degC')`, FloatNode(100)},
+		{`convert(energy, 'kWh', 'Wh')`, mustDecimal("1250")},
+		{`convert(decimal('12345678.123456789012'), 'kWh', 'kJ')`, mustDecimal("44444441244.4444404432")},
+		{`convert(decimal('-40'), 'degC', 'degF')`, mustDecimal("-40")},
+		{`convert(missing, 'kWh', 'Wh')`, UNDEFINED_RESULT},
+	}
+	for _, tt := range tests {
+		expr, err := ParseExpr(tt.expr)
+		assert.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, eval(MutilContext{DefaultValue, ctx}, expr), tt.expr)
+	}
+
+	// a division which does not terminate is rounded as the divisions of the rule.
+	expr, err := ParseExpr(`convert(decimal('100'), 'km/h', 'm/s')`)
+	assert.NoError(t, err)
+	assert.Equal(t, "27.7777777777777778", eval(DefaultValue, expr).String())
+	assert.Equal(t, "27.77", eval(ContextWithOptions(DefaultValue, WithDecimalDivision(2, RoundDown)), expr).String())
+
+	expr, err = ParseExpr(`convert(temp, 'degF', 'bar')`)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(eval(MutilContext{DefaultValue, ctx}, expr).Error(), ErrIncompatibleUnits))
+
+	units := NewUnitRegistry()
+	assert.NoError(t, units.Register("rpm", Unit{Dimension: "frequency", Factor: 1.0 / 60}))
+	assert.NoError(t, units.Register("Hz", Unit{Dimension: "frequency", Factor: 1}))
+	tql, err := NewTDTL(`insert into target select convert(motor.speed, 'rpm', 'Hz') as freq`, nil, WithUnits(units))
+	assert.NoError(t, err)
+	ret, err := tql.Exec(map[string]Node{"motor": New(`{"speed": 1500}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, "25", ret["freq"].String())
+}
@@ -16,7 +16,6 @@ limitations under the License.
 package tdtl
 
 import (
-	"fmt"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/tkeel-io/tdtl/parser"
 )
@@ -83,6 +82,8 @@ func parse(expr string) (*parser.TDTLParser, *TDTLListener) {
 	return parse, &listener
 }
 
+// ParseFunc returns the calls of x in the order of the text, an outer call before
+// the calls of its arguments.
 func ParseFunc(x Expr) []*CallExpr {
 	ret := &callList{make([]*CallExpr, 0)}
 	ret.walkFunc(x)
@@ -107,7 +108,9 @@ func (c *callList) walkFunc(x Expr) {
 	case *FieldExpr:
 		c.walkFunc(x.exp)
 	case *FilterExpr:
-		c.walkFunc(x.exp)
+		if x != nil {
+			c.walkFunc(x.exp)
+		}
 	case *BinaryExpr:
 		c.walkFunc(x.LHS)
 		c.walkFunc(x.RHS)
@@ -115,16 +118,23 @@ func (c *callList) walkFunc(x Expr) {
 		c.walkFunc(x.exp)
 		for i, n := 0, len(x.list); i < n; i++ {
 			elem := x.list[i]
-			c.walkFunc(x.exp)
 			c.walkFunc(elem.when)
 			c.walkFunc(elem.then)
 		}
+		c.walkFunc(x.last)
 	case *CaseExpr:
 		c.walkFunc(x.then)
+	case *InExpr:
+		c.walkFunc(x.exp)
+		for _, elem := range x.list {
+			c.walkFunc(elem)
+		}
 	case *CallExpr:
 		c.list = append(c.list, x)
+		for _, arg := range x.args {
+			c.walkFunc(arg)
+		}
 	default:
-		// default
-		fmt.Printf("%v", x)
+		// literals and json paths have no calls.
 	}
 }